@@ -0,0 +1,130 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/rocketlaunchercloud/rlctl/pkg/rlctl"
+	"github.com/rocketlaunchercloud/rlctl/util"
+	"github.com/spf13/cobra"
+	"io"
+	"log"
+	"os"
+	"strings"
+	"text/tabwriter"
+)
+
+const (
+	namespace = "namespace"
+	format    = "format"
+	strict    = "strict"
+
+	formatTable    = "table"
+	formatJson     = "json"
+	formatMarkdown = "markdown"
+)
+
+var (
+	cmdAudit = &cobra.Command{
+		Use:   "audit",
+		Short: "audit command reports the GitLab projects of a namespace which are behind the current templates.",
+		Long: `audit command reports the GitLab projects of a namespace which are behind the current templates.
+It reads the rlctl.yaml manifest stored by spring in every project of the namespace and its subgroups, and reports
+projects generated before a template change affecting their language, managed files (.gitlab-ci.yml, Dockerfile,
+Kubernetes manifests) which differ from the current templates and failed policy checks, e.g. deprecated Kubernetes
+API versions.`,
+		Args: cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			group := stringFlag(cmd, namespace)
+			exitOnError(util.ValidateRequired(group, namespace))
+			outputFormat := stringFlag(cmd, format)
+			if outputFormat != formatTable && outputFormat != formatJson && outputFormat != formatMarkdown {
+				exitOnError(util.NewValidationError("invalid %s %s, expected one of table, json, markdown", format, outputFormat))
+			}
+
+			client := rlctl.NewGitlabClient(getOrSetToken(cmd))
+			if url := stringFlag(cmd, gitlabUrl); url != "" {
+				client.BaseUrl = url
+			}
+			options := rlctl.AuditOptions{
+				Concurrency: int(int32Flag(cmd, concurrency)),
+				Preset:      bootstrapPreset(cmd),
+			}
+			log.Printf("Auditing the projects of %s\n", group)
+			results, err := rlctl.Audit(context.Background(), client, group, options)
+			exitOnError(err)
+			exitOnError(printAudit(os.Stdout, outputFormat, results))
+
+			if boolFlag(cmd, strict) {
+				exitOnError(auditFailure(results))
+			}
+		},
+	}
+)
+
+func init() {
+	cmdAudit.Flags().StringP(namespace, "", "", "Numeric id or full path of the GitLab group, its subgroups are audited as well")
+	cmdAudit.Flags().StringP(format, "", formatTable, "Output format: table, json or markdown")
+	cmdAudit.Flags().Int32P(concurrency, "c", rlctl.DefaultAuditConcurrency, "Number of projects audited at the same time")
+	cmdAudit.Flags().StringP(preset, "", "", "Preset name, file or URL, projects which change a locked setting fail the preset check")
+	cmdAudit.Flags().BoolP(strict, "", false, "Fail if a project is not current or fails a check")
+	cmdAudit.Flags().StringP(gitlabUrl, "", "", "URL of the GitLab API, e.g. https://gitlab.example.com/api/v4 (default \"https://git.flix.tech/api/v4\")")
+	cmdAudit.Flags().StringP(Token, "", "", "Gitlab token, it is stored in the keyring. Prefer $RLCTL_GITLAB_TOKEN to keep it out of the shell history.")
+}
+
+func printAudit(writer io.Writer, outputFormat string, results []rlctl.AuditResult) error {
+	switch outputFormat {
+	case formatJson:
+		encoder := json.NewEncoder(writer)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(results)
+	case formatMarkdown:
+		fmt.Fprintln(writer, "| Project | Status | Template | Diverged | Checks |")
+		fmt.Fprintln(writer, "|---|---|---|---|---|")
+		for _, result := range results {
+			project := result.Project
+			if result.WebUrl != "" {
+				project = fmt.Sprintf("[%s](%s)", result.Project, result.WebUrl)
+			}
+			fmt.Fprintf(writer, "| %s | %s | %s | %s | %s |\n", project, auditStatus(result), auditVersion(result),
+				strings.Join(result.Diverged, "<br>"), strings.Join(result.FailedChecks, "<br>"))
+		}
+		return nil
+	default:
+		table := tabwriter.NewWriter(writer, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(table, "PROJECT\tSTATUS\tTEMPLATE\tDIVERGED\tCHECKS")
+		for _, result := range results {
+			fmt.Fprintf(table, "%s\t%s\t%s\t%s\t%s\n", result.Project, auditStatus(result), auditVersion(result),
+				strings.Join(result.Diverged, ", "), strings.Join(result.FailedChecks, "; "))
+		}
+		return table.Flush()
+	}
+}
+
+func auditStatus(result rlctl.AuditResult) string {
+	if result.Error != "" {
+		return fmt.Sprintf("%s (%s)", result.Status, result.Error)
+	}
+	return string(result.Status)
+}
+
+func auditVersion(result rlctl.AuditResult) string {
+	if result.TemplateVersion == 0 {
+		return "-"
+	}
+	return fmt.Sprint(result.TemplateVersion)
+}
+
+// auditFailure returns a validation error if a project is not current or fails a check.
+func auditFailure(results []rlctl.AuditResult) error {
+	failed := 0
+	for _, result := range results {
+		if result.Status != rlctl.AuditCurrent || len(result.FailedChecks) > 0 {
+			failed++
+		}
+	}
+	if failed > 0 {
+		return util.NewValidationError("%d of %d projects are not current or fail a check", failed, len(results))
+	}
+	return nil
+}
@@ -0,0 +1,152 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"github.com/rocketlaunchercloud/rlctl/pkg/rlctl"
+	"github.com/rocketlaunchercloud/rlctl/project/spring"
+	"github.com/rocketlaunchercloud/rlctl/util"
+	"github.com/spf13/cobra"
+	"log"
+	"os"
+	"sync"
+	"text/tabwriter"
+)
+
+const (
+	inventory   = "inventory"
+	concurrency = "concurrency"
+	gitlabUrl   = "gitlab-url"
+)
+
+var (
+	cmdBootstrap = &cobra.Command{
+		Use:   "bootstrap",
+		Short: "bootstrap command generates and creates the GitLab projects of all services of an inventory.",
+		Long: `bootstrap command generates and creates the GitLab projects of all services of an inventory.
+The inventory is a YAML file with defaults and a list of services, each a manifest with a gitlab section, or a CSV file
+whose header names the settings, e.g. spring.name,spring.group,gitlab.namespace_id. Services whose GitLab project
+holds the pushed rlctl.yaml are skipped, a failed bootstrap can be run again.`,
+		Args: cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			path := stringFlag(cmd, inventory)
+			exitOnError(util.ValidateRequired(path, inventory))
+
+			springPreset := bootstrapPreset(cmd)
+			defaults := bootstrapDefaults(springPreset)
+			services, err := rlctl.LoadInventory(path, defaults)
+			exitOnError(err)
+
+			client := rlctl.NewGitlabClient(getOrSetToken(cmd))
+			if url := stringFlag(cmd, gitlabUrl); url != "" {
+				client.BaseUrl = url
+			}
+			total := len(services.Services)
+			var mutex sync.Mutex
+			done := 0
+			options := rlctl.BootstrapOptions{
+				OutputDirectory: stringFlag(cmd, outputDir),
+				Policy:          generationPolicy(cmd),
+				Concurrency:     int(int32Flag(cmd, concurrency)),
+				Preset:          springPreset,
+				Progress: func(event rlctl.BootstrapEvent) {
+					log.Printf("%s: %s\n", event.Service, event.Step)
+				},
+				Done: func(result rlctl.BootstrapResult) {
+					mutex.Lock()
+					defer mutex.Unlock()
+					done++
+					log.Printf("[%d/%d] %s %s\n", done, total, result.Service, result.Status)
+				},
+			}
+			log.Printf("Bootstrapping %d services, %d at a time\n", total, options.Concurrency)
+			results := rlctl.Bootstrap(context.Background(), client, *services, options)
+			exitOnError(printBootstrapSummary(results))
+		},
+	}
+)
+
+func init() {
+	cmdBootstrap.Flags().StringP(inventory, "", "", "YAML or CSV file listing the services")
+	cmdBootstrap.Flags().Int32P(concurrency, "c", rlctl.DefaultBootstrapConcurrency, "Number of services bootstrapped at the same time")
+	cmdBootstrap.Flags().StringP(outputDir, "o", "", "Directory the services are generated into, each into a directory named after the service (default \"build\")")
+	cmdBootstrap.Flags().StringP(preset, "", "", "Preset name, file or URL whose settings are the defaults of every service, locked settings must not be changed")
+	cmdBootstrap.Flags().BoolP(force, "", false, "Replace the content of existing output directories, .git is kept")
+	cmdBootstrap.Flags().BoolP(merge, "", false, "Generate over existing output directories, generated files replace existing ones, YAML files are merged")
+	cmdBootstrap.Flags().StringP(gitlabUrl, "", "", "URL of the GitLab API, e.g. https://gitlab.example.com/api/v4 (default \"https://git.flix.tech/api/v4\")")
+	cmdBootstrap.Flags().StringP(Token, "", "", "Gitlab token, it is stored in the keyring. Prefer $RLCTL_GITLAB_TOKEN to keep it out of the shell history.")
+}
+
+// bootstrapPreset loads the preset of the flag or of the spring section of the config files.
+func bootstrapPreset(cmd *cobra.Command) *spring.Preset {
+	source := stringFlag(cmd, preset)
+	if value, found := util.ConfigSection(loadConfig(), springSection)[preset]; found && !cmd.Flags().Changed(preset) {
+		source = util.FlagString(value)
+	}
+	if source == "" {
+		return nil
+	}
+	springPreset, err := spring.LoadPreset(context.Background(), source)
+	exitOnError(err)
+	log.Printf("Using preset %s\n", springPreset.Name)
+	return springPreset
+}
+
+// bootstrapDefaults returns the manifest settings of the config files overridden by the preset, the defaults of the
+// inventory take precedence.
+func bootstrapDefaults(springPreset *spring.Preset) rlctl.Sections {
+	values := loadConfig()
+	defaults := rlctl.Sections{}
+	for _, section := range []string{springSection, gitlabSection} {
+		defaults[section] = map[string]interface{}{}
+		for key, value := range util.ConfigSection(values, section) {
+			if rlctl.IsManifestSetting(section, key) {
+				defaults[section][key] = value
+			}
+		}
+	}
+	if springPreset != nil {
+		for key, value := range springPreset.Spring {
+			defaults[springSection][key] = value
+		}
+	}
+	return defaults
+}
+
+// printBootstrapSummary prints a table of the results and the created deploy tokens. It returns an error if a
+// service failed, its kind is the kind of the first failure.
+func printBootstrapSummary(results []rlctl.BootstrapResult) error {
+	writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(writer, "SERVICE\tSTATUS\tREPOSITORY\tERROR")
+	var failure error
+	failed := 0
+	for _, result := range results {
+		repository, message := "", ""
+		if result.Project != nil {
+			repository = result.Project.WebUrl
+		}
+		if result.Err != nil {
+			message = result.Err.Error()
+			failed++
+			if failure == nil {
+				failure = result.Err
+			}
+		}
+		fmt.Fprintf(writer, "%s\t%s\t%s\t%s\n", result.Service, result.Status, repository, message)
+	}
+	if err := writer.Flush(); err != nil {
+		return err
+	}
+
+	for _, result := range results {
+		if result.DeployToken != nil {
+			// GitLab shows the token only once.
+			fmt.Printf("%s deploy token %s: username=%s token=%s\n", result.Service, result.DeployToken.Name,
+				result.DeployToken.Username, result.DeployToken.Token)
+		}
+	}
+	if failure != nil {
+		return &util.Error{Kind: util.KindOf(failure), Err: fmt.Errorf("%d of %d services failed", failed, len(results))}
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+package cmd
+
+import (
+	"fmt"
+	"github.com/rocketlaunchercloud/rlctl/util"
+	"github.com/spf13/cobra"
+	"log"
+	"time"
+)
+
+const (
+	olderThan = "older-than"
+)
+
+var (
+	cmdCache = &cobra.Command{
+		Use:   "cache",
+		Short: "cache command manages the cached Spring Initializr downloads and wrapper artifacts.",
+		Long:  `cache command manages the cached Spring Initializr downloads and wrapper artifacts.`,
+	}
+
+	cacheListCommand = &cobra.Command{
+		Use:   "ls",
+		Short: "ls command lists the cache entries.",
+		Long:  `ls command lists the cache entries.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			dir, err := util.CacheDirectory()
+			exitOnError(err)
+			entries, err := util.ListCached()
+			exitOnError(err)
+
+			log.Printf("Cache directory = %s\n", dir)
+			for _, entry := range entries {
+				age := time.Since(entry.CreatedAt).Round(time.Second)
+				log.Println(fmt.Sprintf("Age = %s, Size = %d, SHA256 = %s, Key = %s", age, entry.Size, entry.Checksum[:12], entry.Key))
+			}
+		},
+	}
+
+	cacheCleanCommand = &cobra.Command{
+		Use:   "clean",
+		Short: "clean command removes the cache entries.",
+		Long:  `clean command removes the cache entries.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			removed, err := util.CleanCached(durationFlag(cmd, olderThan))
+			exitOnError(err)
+
+			log.Printf("%d cache entries removed\n", removed)
+		},
+	}
+)
+
+func init() {
+	cacheCleanCommand.Flags().DurationP(olderThan, "", 0, "Only remove entries older than the given duration, e.g. 72h")
+
+	cmdCache.AddCommand(cacheListCommand)
+	cmdCache.AddCommand(cacheCleanCommand)
+}
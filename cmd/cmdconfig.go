@@ -0,0 +1,264 @@
+package cmd
+
+import (
+	"fmt"
+	"github.com/rocketlaunchercloud/rlctl/util"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	local = "local"
+)
+
+var (
+	cmdConfig = &cobra.Command{
+		Use:   "config",
+		Short: "config command views and changes the settings of ~/.rlctl.yaml and the .rlctl.yaml overlays.",
+		Long: `config command views and changes the settings of ~/.rlctl.yaml and the .rlctl.yaml overlays.
+Overlays are read from the current directory and its parents, the closest one takes precedence. Settings of the spring
+and gitlab sections, e.g. spring.container-registry, are defaults of the flags of the spring and gitlab commands.`,
+	}
+
+	configGetCommand = &cobra.Command{
+		Use:   "get <key>",
+		Short: "get command prints the effective value of the setting.",
+		Long:  `get command prints the effective value of the setting.`,
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			values := loadConfig()
+			value, found := values[args[0]]
+			if !found {
+				exitOnError(util.NewValidationError("%s is not set", args[0]))
+			}
+			fmt.Fprintln(cmd.OutOrStdout(), util.FlagString(value.Value))
+		},
+	}
+
+	configSetCommand = &cobra.Command{
+		Use:   "set <key> <value>",
+		Short: "set command sets the setting in ~/.rlctl.yaml or with --local in ./.rlctl.yaml.",
+		Long: `set command sets the setting in ~/.rlctl.yaml or with --local in ./.rlctl.yaml.
+Lists, e.g. spring.gitlab-ci-tags, are comma separated.`,
+		Args: cobra.ExactArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			file := configFile(cmd)
+			key, value := args[0], args[1]
+			exitOnError(checkSetting(key, value, file != userConfigPath()))
+
+			values, err := util.LoadConfigFile(file)
+			exitOnError(err)
+			values[key] = settingValue(key, value)
+			exitOnError(util.SaveConfigFile(file, values))
+		},
+	}
+
+	configUnsetCommand = &cobra.Command{
+		Use:   "unset <key>",
+		Short: "unset command removes the setting from ~/.rlctl.yaml or with --local from ./.rlctl.yaml.",
+		Long:  `unset command removes the setting from ~/.rlctl.yaml or with --local from ./.rlctl.yaml.`,
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			file := configFile(cmd)
+			values, err := util.LoadConfigFile(file)
+			exitOnError(err)
+			if _, found := values[args[0]]; !found {
+				exitOnError(util.NewValidationError("%s is not set in %s", args[0], file))
+			}
+			delete(values, args[0])
+			exitOnError(util.SaveConfigFile(file, values))
+		},
+	}
+
+	configListCommand = &cobra.Command{
+		Use:   "list",
+		Short: "list command prints the effective settings and the file they are read from.",
+		Long:  `list command prints the effective settings and the file they are read from.`,
+		Args:  cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			values := loadConfig()
+			keys := make([]string, 0, len(values))
+			for key := range values {
+				keys = append(keys, key)
+			}
+			sort.Strings(keys)
+			for _, key := range keys {
+				fmt.Fprintf(cmd.OutOrStdout(), "%s=%s\t# %s\n", key, util.FlagString(values[key].Value), values[key].Source)
+			}
+		},
+	}
+
+	configPathCommand = &cobra.Command{
+		Use:   "path",
+		Short: "path command prints the config files in the order they are applied.",
+		Long:  `path command prints the config files in the order they are applied.`,
+		Args:  cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			dir, err := os.Getwd()
+			exitOnError(err)
+			overlays, err := util.ConfigOverlays(dir)
+			exitOnError(err)
+			for _, file := range append([]string{userConfigPath()}, overlays...) {
+				fmt.Fprintln(cmd.OutOrStdout(), file)
+			}
+		},
+	}
+
+	configValidateCommand = &cobra.Command{
+		Use:   "validate",
+		Short: "validate command checks the settings of all config files.",
+		Long:  `validate command checks the settings of all config files.`,
+		Args:  cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			dir, err := os.Getwd()
+			exitOnError(err)
+			overlays, err := util.ConfigOverlays(dir)
+			exitOnError(err)
+
+			problems := 0
+			for _, file := range append([]string{userConfigPath()}, overlays...) {
+				values, err := util.LoadConfigFile(file)
+				if err != nil {
+					fmt.Fprintln(cmd.OutOrStdout(), err)
+					problems++
+					continue
+				}
+				for key, value := range values {
+					if err = checkSetting(key, util.FlagString(value), file != userConfigPath()); err != nil {
+						fmt.Fprintf(cmd.OutOrStdout(), "%s: %v\n", file, err)
+						problems++
+					}
+				}
+			}
+			if problems > 0 {
+				exitOnError(util.NewValidationError("%d invalid settings", problems))
+			}
+			fmt.Fprintln(cmd.OutOrStdout(), "The config is valid!")
+		},
+	}
+)
+
+func init() {
+	configSetCommand.Flags().BoolP(local, "", false, "Change ./.rlctl.yaml instead of ~/.rlctl.yaml")
+	configUnsetCommand.Flags().BoolP(local, "", false, "Change ./.rlctl.yaml instead of ~/.rlctl.yaml")
+
+	cmdConfig.AddCommand(configGetCommand)
+	cmdConfig.AddCommand(configSetCommand)
+	cmdConfig.AddCommand(configUnsetCommand)
+	cmdConfig.AddCommand(configListCommand)
+	cmdConfig.AddCommand(configPathCommand)
+	cmdConfig.AddCommand(configValidateCommand)
+}
+
+// applyConfig sets the flags which were set neither on the command line nor by a manifest from the section of the
+// config files.
+func applyConfig(cmd *cobra.Command, section string) error {
+	values := util.ConfigSection(loadConfig(), section)
+	for key, value := range values {
+		if err := checkSetting(section+"."+key, util.FlagString(value), false); err != nil {
+			return err
+		}
+	}
+	return util.SetFlagDefaults(cmd, values)
+}
+
+// sectionCommand returns the command whose flags are configured by the section. It is looked up by name, referring to
+// the command variables would be an initialization loop.
+func sectionCommand(section string) *cobra.Command {
+	if section != springSection && section != gitlabSection {
+		return nil
+	}
+	for _, command := range rootCmd.Commands() {
+		if command.Name() == section {
+			return command
+		}
+	}
+	return nil
+}
+
+// configurableFlag returns the flag of the setting, flags selecting files, modes or secrets are not settings.
+func configurableFlag(key string) *pflag.Flag {
+	parts := strings.SplitN(key, ".", 2)
+	command := sectionCommand(parts[0])
+	if command == nil || len(parts) == 1 {
+		return nil
+	}
+	switch parts[1] {
+	case "help", Token, manifestFile, saveManifest, interactive, force, merge:
+		return nil
+	}
+	return command.Flags().Lookup(parts[1])
+}
+
+// checkSetting validates the key and value, overlay settings must not configure commands rlctl runs, downloads or
+// output directories.
+func checkSetting(key, value string, overlay bool) error {
+	if overlay && util.IsUserConfigSetting(key) {
+		return util.NewValidationError("%s is only allowed in %s", key, userConfigPath())
+	}
+	switch key {
+	case util.GitlabTokenCommand:
+		return nil
+	case util.GitlabToken:
+		return util.NewValidationError("%s is a plain text token, run rlctl gitlab namespaces to move it into the keyring", key)
+	}
+	flag := configurableFlag(key)
+	if flag == nil {
+		return util.NewValidationError("unknown setting %s", key)
+	}
+	return util.ValidateFlagValue(flag, value)
+}
+
+// settingValue converts the value to the YAML type of the flag, lists are comma separated.
+func settingValue(key, value string) interface{} {
+	flag := configurableFlag(key)
+	if flag == nil {
+		return value
+	}
+	if _, ok := flag.Value.(pflag.SliceValue); ok {
+		items := []string{}
+		for _, item := range strings.Split(value, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				items = append(items, item)
+			}
+		}
+		return items
+	}
+	switch flag.Value.Type() {
+	case "bool":
+		b, _ := strconv.ParseBool(value)
+		return b
+	case "int32":
+		i, _ := strconv.Atoi(value)
+		return i
+	}
+	return value
+}
+
+func loadConfig() map[string]util.ConfigValue {
+	dir, err := os.Getwd()
+	exitOnError(err)
+	values, err := util.LoadConfig(dir)
+	exitOnError(err)
+	return values
+}
+
+func userConfigPath() string {
+	file, err := util.ConfigPath()
+	exitOnError(err)
+	return file
+}
+
+func configFile(cmd *cobra.Command) string {
+	if !boolFlag(cmd, local) {
+		return userConfigPath()
+	}
+	file, err := filepath.Abs(util.ConfigFileName)
+	exitOnError(err)
+	return file
+}
@@ -0,0 +1,47 @@
+package cmd
+
+import (
+	"fmt"
+	"github.com/rocketlaunchercloud/rlctl/project/spring"
+	"github.com/spf13/cobra"
+	"log"
+	"strings"
+)
+
+const projectDir = "project-dir"
+
+var (
+	addCommand = &cobra.Command{
+		Use:   fmt.Sprintf("add <%s>", strings.Join(spring.Features(), "|")),
+		Short: "add command adds a feature to an existing spring project.",
+		Long: `add command adds a feature to an existing spring project.
+The settings are read from the rlctl.yaml of the project. Plugins, dependencies and configuration blocks are added to
+build.gradle or build.gradle.kts, keys the feature adds are merged into config/application*.yml and the pipeline
+files, existing keys are kept. The feature is enabled in rlctl.yaml, adding a feature twice changes nothing.`,
+		Args:      cobra.ExactValidArgs(1),
+		ValidArgs: spring.Features(),
+		Run: func(cmd *cobra.Command, args []string) {
+			result, err := spring.AddFeature(stringFlag(cmd, projectDir), args[0])
+			exitOnError(err)
+
+			if len(result.Changed) == 0 {
+				log.Printf("%s is already added\n", args[0])
+			}
+			for _, file := range result.Changed {
+				log.Printf("%s updated\n", file)
+			}
+			for _, conflict := range result.Conflicts {
+				log.Printf("Conflict in %s\n", conflict)
+			}
+			for _, file := range result.Skipped {
+				log.Printf("%s was not updated, add %s to it manually\n", file, args[0])
+			}
+		},
+	}
+)
+
+func init() {
+	addCommand.Flags().StringP(projectDir, "d", ".", "Root directory of the project")
+
+	SpringCommand.AddCommand(addCommand)
+}
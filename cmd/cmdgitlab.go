@@ -1,35 +1,63 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
+	"github.com/rocketlaunchercloud/rlctl/pkg/rlctl"
 	"github.com/rocketlaunchercloud/rlctl/project/gitlab"
 	"github.com/rocketlaunchercloud/rlctl/util"
 	"github.com/spf13/cobra"
 	"log"
+	"os"
 )
 
 var (
-	gitlabConfig gitlab.GitlabConfig
-
 	cmdGitLab = &cobra.Command{
 		Use:   "gitlab",
 		Short: "gitlab command generates a new project in the remote repository.",
 		Long:  `gitlab command generates a new project in the remote repository.`,
 		Run: func(cmd *cobra.Command, args []string) {
-			initGitlabConfig(cmd)
-
-			project, err := gitlab.GitCreateProject(gitlabConfig)
-			util.LogAndExit(err, util.NetworkError)
-
-			if project.Message.Name == nil {
-				log.Println(fmt.Sprintf("Name = %s", project.Name))
-				log.Println(fmt.Sprintf("Full name = %s", project.NameWithNamespace))
-				log.Println(fmt.Sprintf("SSH_URL = %s", project.RepoSshUrl))
-				log.Println(fmt.Sprintf("Http_URL = %s", project.RepoHttpUrl))
-			} else {
-				for _, msg := range project.Message.Name {
-					log.Println(msg)
+			exitOnError(applyManifest(cmd, gitlabSection))
+			exitOnError(applyConfig(cmd, gitlabSection))
+			manifestPath := stringFlag(cmd, saveManifest)
+			if boolFlag(cmd, interactive) {
+				prompter := util.NewPrompter(os.Stdin, os.Stdout)
+				if gitlabToken(cmd) == "" {
+					token, err := prompter.Ask("Gitlab token", "", nil)
+					exitOnError(err)
+					exitOnError(util.SetFlagValue(cmd, Token, token))
+				}
+				confirmed, path, err := gitlabWizard(cmd, prompter, os.Stdout, getOrSetToken(cmd))
+				exitOnError(err)
+				if !confirmed {
+					log.Println("Cancelled!")
+					return
 				}
+				manifestPath = path
+			}
+
+			gitlabConfig := initGitlabConfig(cmd)
+			if manifestPath != "" {
+				exitOnError(writeManifest(manifestPath, func(manifest *rlctl.Manifest) {
+					manifest.Gitlab = &gitlabConfig
+				}))
+			}
+
+			client := gitlab.NewClient(gitlabConfig.Token)
+			project, err := client.CreateProject(context.Background(), gitlabConfig)
+			exitOnError(err)
+
+			log.Println(fmt.Sprintf("Name = %s", project.Name))
+			log.Println(fmt.Sprintf("Full name = %s", project.NameWithNamespace))
+			log.Println(fmt.Sprintf("SSH_URL = %s", project.RepoSshUrl))
+			log.Println(fmt.Sprintf("Http_URL = %s", project.RepoHttpUrl))
+
+			deployToken, err := client.Provision(context.Background(), project, gitlabConfig.ProvisioningConfig)
+			exitOnError(err)
+			if deployToken != nil {
+				// GitLab shows the token only once, it goes to stdout instead of the log.
+				log.Printf("Deploy token %s created, store it now, GitLab does not show it again\n", deployToken.Name)
+				fmt.Printf("username=%s\ntoken=%s\n", deployToken.Username, deployToken.Token)
 			}
 		},
 	}
@@ -41,8 +69,8 @@ var (
 		Run: func(cmd *cobra.Command, args []string) {
 			token := getOrSetToken(cmd)
 
-			namespaces, err := gitlab.GitGetNamespaces(token)
-			util.LogAndExit(err, util.NetworkError)
+			namespaces, err := gitlab.NewClient(token).Namespaces(context.Background())
+			exitOnError(err)
 
 			for _, namespace := range namespaces {
 				log.Println(fmt.Sprintf("Id = %d, Name=%s, FullPath=%s", namespace.Id, namespace.Name, namespace.FullPath))
@@ -62,52 +90,109 @@ const (
 	AutoCancelPendingPipelines                = "auto_cancel_pending_pipelines"
 	ApprovalsBeforeMerge                      = "approvals_before_merge"
 	InitializeWithReadme                      = "initialize_with_readme"
+	ContainerRegistry                         = "container_registry"
+	ContainerCleanupCadence                   = "container_cleanup_cadence"
+	ContainerCleanupKeepN                     = "container_cleanup_keep_n"
+	ContainerCleanupOlderThan                 = "container_cleanup_older_than"
+	ContainerCleanupNameRegex                 = "container_cleanup_name_regex"
+	ContainerCleanupNameRegexKeep             = "container_cleanup_name_regex_keep"
+	DeployToken                               = "deploy_token"
+	DeployTokenScopes                         = "deploy_token_scopes"
+	DeployTokenExpiresAt                      = "deploy_token_expires_at"
+	Environments                              = "environments"
+	StagingUrl                                = "staging_url"
+	ProductionUrl                             = "production_url"
 )
 
 func init() {
 	cmdGitLab.Flags().StringP(AutoCancelPendingPipelines, "", "enabled", "Auto-cancel pending pipelines (Note: this is not a boolean, but enabled/disabled")
-	cmdGitLab.Flags().BoolP(OnlyAllowMergeIfPipelineSucceeds, "", true, "Auto-cancel pending pipelines (Note: this is not a boolean, but enabled/disabled")
+	cmdGitLab.Flags().BoolP(OnlyAllowMergeIfPipelineSucceeds, "", true, "Set whether merge requests can only be merged with successful pipelines")
 	cmdGitLab.Flags().BoolP(OnlyAllowMergeIfAllDiscussionsAreResolved, "", true, "Set whether merge requests can only be merged when all the discussions are resolved")
 	cmdGitLab.Flags().Int32P(ApprovalsBeforeMerge, "", 1, "How many approvers should approve merge requests by default")
 	cmdGitLab.Flags().BoolP(InitializeWithReadme, "r", true, "Initialise by README.md")
 	cmdGitLab.Flags().StringP(Name, "n", "", "The name of the new project. Equals path if not provided.")
 	cmdGitLab.Flags().Int32P(NamespaceID, "", 0, "Namespace for the new project (defaults to the current user’s namespace)")
 	cmdGitLab.Flags().StringP(Path, "p", "", "Repository name for new project. Generated based on name if not provided (generated lowercased with dashes).")
-	cmdGitLab.Flags().StringP(Token, "", "", "Gitlab token.")
+	cmdGitLab.Flags().StringP(Token, "", "", "Gitlab token, it is stored in the keyring. Prefer $RLCTL_GITLAB_TOKEN to keep it out of the shell history.")
 	cmdGitLab.Flags().StringP(Visibility, "v", "private", "private|internal|public")
+	cmdGitLab.Flags().BoolP(ContainerRegistry, "", false, "Enable the container registry with the cleanup policy of the container_cleanup flags")
+	cmdGitLab.Flags().StringP(ContainerCleanupCadence, "", gitlab.DefaultCleanupCadence, "How often the cleanup policy runs: 1d|7d|14d|1month|3month")
+	cmdGitLab.Flags().Int32P(ContainerCleanupKeepN, "", gitlab.DefaultCleanupKeepN, "Number of tags kept per image: 1|5|10|25|50|100")
+	cmdGitLab.Flags().StringP(ContainerCleanupOlderThan, "", gitlab.DefaultCleanupOlderThan, "Tags older than this are removed: 7d|14d|30d|90d")
+	cmdGitLab.Flags().StringP(ContainerCleanupNameRegex, "", gitlab.DefaultCleanupNameRegex, "Regex of the tags the cleanup policy removes")
+	cmdGitLab.Flags().StringP(ContainerCleanupNameRegexKeep, "", "", "Regex of the tags the cleanup policy keeps")
+	cmdGitLab.Flags().StringP(DeployToken, "", "", "Name of a deploy token to create, e.g. for the image pull secret of the clusters")
+	cmdGitLab.Flags().StringArrayP(DeployTokenScopes, "", gitlab.DefaultDeployTokenScopes, "Scopes of the deploy token, the flag can be repeated")
+	cmdGitLab.Flags().StringP(DeployTokenExpiresAt, "", "", "Expiry date of the deploy token (YYYY-MM-DD), it does not expire by default")
+	cmdGitLab.Flags().BoolP(Environments, "", false, "Register the staging and production environments of the generated pipeline")
+	cmdGitLab.Flags().StringP(StagingUrl, "", "", "URL of the staging environment, see spring --gitlab-ci-staging-url")
+	cmdGitLab.Flags().StringP(ProductionUrl, "", "", "URL of the production environment, see spring --gitlab-ci-prod-url")
 
-	namespacesCommand.Flags().StringP(Token, "", "", "Gitlab token.")
+	namespacesCommand.Flags().StringP(Token, "", "", "Gitlab token, it is stored in the keyring. Prefer $RLCTL_GITLAB_TOKEN to keep it out of the shell history.")
+
+	addManifestFlags(cmdGitLab)
 
 	cmdGitLab.AddCommand(namespacesCommand)
 }
 
-func initGitlabConfig(cmd *cobra.Command) {
+func initGitlabConfig(cmd *cobra.Command) gitlab.GitlabConfig {
+	var gitlabConfig gitlab.GitlabConfig
+
 	//Mandatory flags
-	getOrSetToken(cmd)
-	gitlabConfig.Name = util.GetValue(cmd, Name)
-	util.ValidateRequired(gitlabConfig.Name, Name)
-	gitlabConfig.NamespaceID = util.GetValueInt32(cmd, NamespaceID)
+	gitlabConfig.Token = getOrSetToken(cmd)
+	gitlabConfig.Name = stringFlag(cmd, Name)
+	exitOnError(util.ValidateRequired(gitlabConfig.Name, Name))
+	gitlabConfig.NamespaceID = int32Flag(cmd, NamespaceID)
 	if gitlabConfig.NamespaceID == 0 {
-		util.LogMessageAndExit(fmt.Sprintf("%s is mandatory!\n", NamespaceID))
+		exitOnError(util.NewValidationError("%s is mandatory!", NamespaceID))
 	}
 
 	//Optional flags
-	gitlabConfig.Path = util.GetValue(cmd, Path)
-	gitlabConfig.Visibility = util.GetValue(cmd, Visibility)
-	gitlabConfig.AutoCancelPendingPipelines = util.GetValue(cmd, AutoCancelPendingPipelines)
-	gitlabConfig.OnlyAllowMergeIfPipelineSucceeds = util.GetValueBool(cmd, OnlyAllowMergeIfPipelineSucceeds)
-	gitlabConfig.OnlyAllowMergeIfAllDiscussionsAreResolved = util.GetValueBool(cmd, OnlyAllowMergeIfAllDiscussionsAreResolved)
-	gitlabConfig.ApprovalsBeforeMerge = util.GetValueInt32(cmd, ApprovalsBeforeMerge)
-	gitlabConfig.InitializeWithReadme = util.GetValueBool(cmd, InitializeWithReadme)
+	gitlabConfig.Path = stringFlag(cmd, Path)
+	gitlabConfig.Visibility = stringFlag(cmd, Visibility)
+	gitlabConfig.AutoCancelPendingPipelines = stringFlag(cmd, AutoCancelPendingPipelines)
+	gitlabConfig.OnlyAllowMergeIfPipelineSucceeds = boolFlag(cmd, OnlyAllowMergeIfPipelineSucceeds)
+	gitlabConfig.OnlyAllowMergeIfAllDiscussionsAreResolved = boolFlag(cmd, OnlyAllowMergeIfAllDiscussionsAreResolved)
+	gitlabConfig.ApprovalsBeforeMerge = int32Flag(cmd, ApprovalsBeforeMerge)
+	gitlabConfig.InitializeWithReadme = boolFlag(cmd, InitializeWithReadme)
+
+	//Provisioning flags
+	gitlabConfig.ContainerRegistry = boolFlag(cmd, ContainerRegistry)
+	gitlabConfig.ContainerCleanupCadence = stringFlag(cmd, ContainerCleanupCadence)
+	gitlabConfig.ContainerCleanupKeepN = int32Flag(cmd, ContainerCleanupKeepN)
+	gitlabConfig.ContainerCleanupOlderThan = stringFlag(cmd, ContainerCleanupOlderThan)
+	gitlabConfig.ContainerCleanupNameRegex = stringFlag(cmd, ContainerCleanupNameRegex)
+	gitlabConfig.ContainerCleanupNameRegexKeep = stringFlag(cmd, ContainerCleanupNameRegexKeep)
+	gitlabConfig.DeployToken = stringFlag(cmd, DeployToken)
+	gitlabConfig.DeployTokenScopes = stringsFlag(cmd, DeployTokenScopes)
+	gitlabConfig.DeployTokenExpiresAt = stringFlag(cmd, DeployTokenExpiresAt)
+	gitlabConfig.Environments = boolFlag(cmd, Environments)
+	gitlabConfig.StagingUrl = stringFlag(cmd, StagingUrl)
+	gitlabConfig.ProductionUrl = stringFlag(cmd, ProductionUrl)
+	exitOnError(gitlabConfig.ProvisioningConfig.Validate())
+
+	return gitlabConfig
+}
+
+// gitlabToken returns the --token flag or the token of the configured token providers.
+func gitlabToken(cmd *cobra.Command) string {
+	token := stringFlag(cmd, Token)
+	if len(token) == 0 {
+		var err error
+		token, err = util.GetGitlabToken()
+		exitOnError(err)
+	}
+	return token
 }
 
+// getOrSetToken returns the token, a token passed by flag is stored in the keyring.
 func getOrSetToken(cmd *cobra.Command) string {
-	gitlabConfig.Token = util.GetValue(cmd, Token)
-	if len(gitlabConfig.Token) == 0 {
-		gitlabConfig.Token = util.GetGitlabToken()
+	token := stringFlag(cmd, Token)
+	if len(token) == 0 {
+		token = gitlabToken(cmd)
 	} else {
-		util.SetGitlabToken(gitlabConfig.Token)
+		exitOnError(util.SetGitlabToken(token))
 	}
-	util.ValidateRequired(gitlabConfig.Token, Token)
-	return gitlabConfig.Token
+	exitOnError(util.ValidateRequired(token, Token))
+	return token
 }
@@ -0,0 +1,57 @@
+package cmd
+
+import (
+	"fmt"
+	"github.com/rocketlaunchercloud/rlctl/project/spring"
+	"github.com/spf13/cobra"
+	"log"
+)
+
+var (
+	versionsCommand = &cobra.Command{
+		Use:   "versions",
+		Short: "versions command lists the Spring Boot versions supported by Spring Initializr.",
+		Long:  `versions command lists the Spring Boot versions supported by Spring Initializr.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			metadata, err := spring.GetInitializrMetadata(stringFlag(cmd, initializrUrl))
+			exitOnError(err)
+
+			for _, bootVersion := range metadata.BootVersion.Values {
+				if bootVersion.Id == metadata.BootVersion.Default {
+					log.Println(fmt.Sprintf("Id = %s, Name = %s (default)", bootVersion.Id, bootVersion.Name))
+				} else {
+					log.Println(fmt.Sprintf("Id = %s, Name = %s", bootVersion.Id, bootVersion.Name))
+				}
+			}
+		},
+	}
+
+	dependenciesCommand = &cobra.Command{
+		Use:   "dependencies",
+		Short: "dependencies command lists the dependency ids supported by Spring Initializr.",
+		Long:  `dependencies command lists the dependency ids supported by Spring Initializr.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			metadata, err := spring.GetInitializrMetadata(stringFlag(cmd, initializrUrl))
+			exitOnError(err)
+
+			for _, group := range metadata.Dependencies.Values {
+				log.Println(group.Name)
+				for _, dependency := range group.Values {
+					if dependency.VersionRange != "" {
+						log.Println(fmt.Sprintf("  Id = %s, Name = %s, Boot versions = %s", dependency.Id, dependency.Name, dependency.VersionRange))
+					} else {
+						log.Println(fmt.Sprintf("  Id = %s, Name = %s", dependency.Id, dependency.Name))
+					}
+				}
+			}
+		},
+	}
+)
+
+func init() {
+	versionsCommand.Flags().StringP(initializrUrl, "", spring.DefaultInitializrUrl, "Spring Initializr endpoint")
+	dependenciesCommand.Flags().StringP(initializrUrl, "", spring.DefaultInitializrUrl, "Spring Initializr endpoint")
+
+	SpringCommand.AddCommand(versionsCommand)
+	SpringCommand.AddCommand(dependenciesCommand)
+}
@@ -0,0 +1,45 @@
+package cmd
+
+import (
+	"context"
+	"github.com/rocketlaunchercloud/rlctl/project/server"
+	"github.com/rocketlaunchercloud/rlctl/project/spring"
+	"github.com/spf13/cobra"
+	"log"
+	"net/http"
+)
+
+const (
+	address = "address"
+)
+
+var (
+	cmdServe = &cobra.Command{
+		Use:   "serve",
+		Short: "serve command starts a web UI and JSON API generating projects.",
+		Long:  `serve command starts a web UI and JSON API generating projects.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			token := gitlabToken(cmd)
+
+			var serverPreset *spring.Preset
+			if source := stringFlag(cmd, preset); source != "" {
+				var err error
+				serverPreset, err = spring.LoadPreset(context.Background(), source)
+				exitOnError(err)
+				log.Printf("Using preset %s\n", serverPreset.Name)
+			}
+
+			listen := stringFlag(cmd, address)
+			log.Printf("Listening on http://%s\n", listen)
+			handler, err := server.NewServer(listen, token, serverPreset)
+			exitOnError(err)
+			exitOnError(http.ListenAndServe(listen, handler))
+		},
+	}
+)
+
+func init() {
+	cmdServe.Flags().StringP(address, "", "localhost:8090", "Address the server listens on")
+	cmdServe.Flags().StringP(preset, "", "", "Preset name, file or URL applied to every request, locked settings must not be changed")
+	cmdServe.Flags().StringP(Token, "", "", "Gitlab token used to create projects from the web UI unless it sends a token.")
+}
@@ -1,12 +1,15 @@
 package cmd
 
 import (
-	"github.com/rocketlaunchercloud/rlctl/project/git"
+	"context"
+	"github.com/rocketlaunchercloud/rlctl/pkg/rlctl"
+	"github.com/rocketlaunchercloud/rlctl/project/gitlab"
 	"github.com/rocketlaunchercloud/rlctl/project/spring"
 	"github.com/rocketlaunchercloud/rlctl/util"
 	"github.com/spf13/cobra"
 	"log"
-	"net/url"
+	"os"
+	"path"
 )
 
 const (
@@ -17,6 +20,12 @@ const (
 	version                 = "version"
 	javaSourceCompatibility = "java-source-compatibility"
 	buildTool               = "build-tool"
+	offline                 = "offline"
+	initializrUrl           = "initializr-url"
+	packaging               = "packaging"
+	packageName             = "package-name"
+	cacheTTL                = "cache-ttl"
+	noCache                 = "no-cache"
 	springBootVersion       = "spring-boot-version"
 	serverPort              = "server-port"
 	serverHost              = "server-host"
@@ -29,67 +38,88 @@ const (
 	kafkaEnabled            = "kafka-enabled"
 	azureEnabled            = "azure-enabled"
 	gitlabCIEnabled         = "gitlab-ci-enabled"
+	ciPipeline              = "ci"
 	jacocoEnabled           = "jacoco-enabled"
+	jibEnabled              = "jib-enabled"
 	buildPath               = "build-path"
+	outputDir               = "output-dir"
+	force                   = "force"
+	merge                   = "merge"
 	sonarEnabled            = "sonar-enabled"
+	preset                  = "preset"
 
-	gitRepoUrl = "git-repo-url"
+	gitRepoUrl    = "git-repo-url"
+	gitBranch     = "git-branch"
+	gitAuthor     = "git-author"
+	gitSign       = "git-sign"
+	gitSigningKey = "git-signing-key"
+	gitSSHKey     = "git-ssh-key"
+	gitPush       = "git-push"
+
+	mergeRequest          = "merge-request"
+	mergeRequestBranch    = "merge-request-branch"
+	mergeRequestTarget    = "merge-request-target"
+	mergeRequestTitle     = "merge-request-title"
+	mergeRequestAssignees = "merge-request-assignees"
+	mergeRequestReviewers = "merge-request-reviewers"
+	mergeRequestLabels    = "merge-request-labels"
 )
 
 var (
-	springProjectConfig spring.SpringProjectConfig
-
-	gitRepositoryUrl string
-
 	SpringCommand = &cobra.Command{
 		Use:   "spring",
 		Short: "spring command generates a new spring project",
 		Long:  `spring command generates a new spring project.`,
 		Run: func(cmd *cobra.Command, args []string) {
-			initSpringCmdConfig(cmd)
-
-			projectRootPath, err := spring.GenerateSpringProject(&springProjectConfig)
-
-			util.LogAndExit(err, util.NetworkError)
-
-			log.Printf("Spring Boot project created successfully under :%s \n", projectRootPath)
-
-			switch springProjectConfig.BuildTool {
-			case spring.Gradle:
-				if springProjectConfig.Language == spring.Java {
-					spring.OverwriteJavaGradleBuild(&projectRootPath, &springProjectConfig)
-				} else if springProjectConfig.Language == spring.Kotlin {
-					err := spring.OverwriteKotlinGradleBuild(&projectRootPath, &springProjectConfig)
-					util.LogAndExit(err, util.NetworkError)
+			exitOnError(applyManifest(cmd, springSection))
+			springPreset := applyPreset(cmd)
+			exitOnError(applyConfig(cmd, springSection))
+			manifestPath := stringFlag(cmd, saveManifest)
+			if boolFlag(cmd, interactive) {
+				confirmed, path, err := springWizard(cmd, util.NewPrompter(os.Stdin, os.Stdout), os.Stdout, springPreset)
+				exitOnError(err)
+				if !confirmed {
+					log.Println("Cancelled!")
+					return
 				}
-				spring.CreateGradleDockerfile(&projectRootPath, &springProjectConfig)
+				manifestPath = path
 			}
 
-			spring.ParseAndSaveAppConfigTemplates(projectRootPath, &springProjectConfig)
+			springProjectConfig := initSpringCmdConfig(cmd)
+			gitRepositoryUrl := stringFlag(cmd, gitRepoUrl)
 
-			if (springProjectConfig).EnableGitLabCI {
-				spring.ParseAndSaveCiCdFile(projectRootPath, &springProjectConfig)
+			if manifestPath != "" {
+				exitOnError(writeManifest(manifestPath, func(manifest *rlctl.Manifest) {
+					manifest.Spring = springProjectConfig
+				}))
 			}
 
-			err = git.ParseAndSaveGitIgnore(projectRootPath)
-			if err != nil {
-				util.LogMessageAndExit("Unable to copy .gitignore")
+			if boolFlag(cmd, mergeRequest) && gitRepositoryUrl == "" {
+				exitOnError(util.NewValidationError("--%s requires --%s", mergeRequest, gitRepoUrl))
+			}
+			if author := stringFlag(cmd, gitAuthor); gitRepositoryUrl != "" && author != "" {
+				_, _, err := util.ParseGitAuthor(author)
+				exitOnError(err)
 			}
 
-			message, err := spring.ParseAndSaveSonarQubeFile(projectRootPath, &springProjectConfig)
-			if err != nil {
-				util.LogMessageAndExit("Unable to copy .gitignore")
+			manifest := rlctl.Manifest{Spring: springProjectConfig}
+			result, err := rlctl.Generate(context.Background(), manifest, rlctl.Options{Policy: generationPolicy(cmd), Preset: springPreset})
+			exitOnError(err)
+			projectRootPath := result.ProjectRoot
+			for _, conflict := range result.Conflicts {
+				log.Printf("Conflict in %s\n", conflict)
 			}
-			log.Println(message)
 
-			spring.SaveK8sTemplates(&projectRootPath, &springProjectConfig)
+			log.Printf("Spring Boot project created successfully under :%s \n", projectRootPath)
+
+			if springProjectConfig.EnableGitLabCI && springProjectConfig.CIPipeline == spring.GitLabCIPipeline {
+				if ciFile := path.Join(projectRootPath, ".gitlab-ci.yml"); !lintGitlabCI(ciFile, "") {
+					exitOnError(util.NewValidationError("the generated %s is invalid", ciFile))
+				}
+			}
 
 			if gitRepositoryUrl != "" {
-				util.GitInitNewRepo(projectRootPath)
-				util.GitAddAll(projectRootPath)
-				util.GitAddRemote(projectRootPath, gitRepositoryUrl)
-				util.GitCommit(projectRootPath, "Initial Commit!")
-				log.Println("Generated files committed to the repository successfully!")
+				publishGitRepository(cmd, projectRootPath, gitRepositoryUrl)
 			}
 		},
 	}
@@ -100,28 +130,41 @@ func init() {
 }
 
 func initGradleCmdFlags() {
-	SpringCommand.Flags().BoolP(azureEnabled, "", false, "Enable Azure Active Directory")
+	defaults := spring.DefaultSpringProjectConfig()
+
+	SpringCommand.Flags().BoolP(azureEnabled, "", defaults.EnableAzureActiveDirectory, "Enable Azure Active Directory")
 	SpringCommand.Flags().StringP(version, "v", "", "Spring boot application version")
 	SpringCommand.Flags().StringP(description, "", "", "Spring application description")
-	SpringCommand.Flags().StringP(serverPort, "", "8080", "Spring boot application port")
-	SpringCommand.Flags().StringP(serverHost, "", "localhost", "Spring application base url host")
-	SpringCommand.Flags().StringP(serverProtocol, "", "http", "Spring application base url protocol")
-	SpringCommand.Flags().StringP(jpaDatabase, "", "MYSQL", "JPA Database Name")
+	SpringCommand.Flags().StringP(serverPort, "", defaults.ServerPort, "Spring boot application port")
+	SpringCommand.Flags().StringP(serverHost, "", defaults.ServerHost, "Spring application base url host")
+	SpringCommand.Flags().StringP(serverProtocol, "", defaults.ServerProtocol, "Spring application base url protocol")
+	SpringCommand.Flags().StringP(jpaDatabase, "", defaults.JpaDatabase, "JPA Database Name")
 	SpringCommand.Flags().StringP(group, "g", "", "Spring application groupId")
-	SpringCommand.Flags().StringP(javaSourceCompatibility, "j", "11", "Java source compatibility version")
-	SpringCommand.Flags().BoolP(jpaEnabled, "", true, "Enable JPA-Hibernate")
-	SpringCommand.Flags().BoolP(liquibaseEnabled, "", false, "Enable Liquibase migration")
-	SpringCommand.Flags().StringP(language, "l", spring.Java, "Spring project language [java | kotlin | groovy]")
+	SpringCommand.Flags().StringP(javaSourceCompatibility, "j", defaults.JavaSourceCompatibility, "Java source compatibility version")
+	SpringCommand.Flags().BoolP(jpaEnabled, "", defaults.EnableJPA, "Enable JPA-Hibernate")
+	SpringCommand.Flags().BoolP(liquibaseEnabled, "", defaults.EnableLiquibase, "Enable Liquibase migration")
+	SpringCommand.Flags().StringP(language, "l", defaults.Language, "Spring project language [java | kotlin | groovy]")
 	SpringCommand.Flags().StringP(name, "", "", "Spring application name")
-	SpringCommand.Flags().BoolP(securityOauth2, "", false, "Enable OAuth2")
-	SpringCommand.Flags().BoolP(securityEnabled, "", false, "Enable Spring security")
-	SpringCommand.Flags().BoolP(kafkaEnabled, "", false, "Enable Kafka integration")
-	SpringCommand.Flags().StringP(springBootVersion, "", spring.SpringBootLatestVersion, "Spring boot version")
-	SpringCommand.Flags().StringP(buildTool, "", spring.Gradle, "Spring project type [gradle-project | maven-project]")
-	SpringCommand.Flags().BoolP(gitlabCIEnabled, "", true, "Create .gitlab-ci config")
-	SpringCommand.Flags().BoolP(jacocoEnabled, "", true, "Enable jacoco integration")
-	SpringCommand.Flags().StringP(buildPath, "", "./build", "Project build path")
-	SpringCommand.Flags().BoolP(sonarEnabled, "", false, "Enable SonarQube integration")
+	SpringCommand.Flags().BoolP(securityOauth2, "", defaults.EnableOAuth2, "Enable OAuth2")
+	SpringCommand.Flags().BoolP(securityEnabled, "", defaults.EnableSecurity, "Enable Spring security")
+	SpringCommand.Flags().BoolP(kafkaEnabled, "", defaults.EnableKafka, "Enable Kafka integration")
+	SpringCommand.Flags().StringP(springBootVersion, "", defaults.SpringBootVersion, "Spring boot version")
+	SpringCommand.Flags().StringP(buildTool, "", defaults.BuildTool, "Spring project type [gradle-project | maven-project]")
+	SpringCommand.Flags().StringP(initializrUrl, "", defaults.InitializrUrl, "Spring Initializr endpoint")
+	SpringCommand.Flags().StringP(packaging, "", defaults.Packaging, "Spring project packaging [jar | war]")
+	SpringCommand.Flags().StringP(packageName, "", "", "Base package name. Generated based on group and name if not provided.")
+	SpringCommand.Flags().DurationP(cacheTTL, "", defaults.CacheTTL, "How long cached Spring Initializr downloads are reused")
+	SpringCommand.Flags().BoolP(noCache, "", false, "Always download from Spring Initializr")
+	SpringCommand.Flags().BoolP(offline, "", defaults.Offline, "Generate the project from bundled templates instead of Spring Initializr (gradle-project only)")
+	SpringCommand.Flags().BoolP(gitlabCIEnabled, "", defaults.EnableGitLabCI, "Create CI pipeline config")
+	SpringCommand.Flags().StringP(ciPipeline, "", defaults.CIPipeline, "CI pipeline generator [gitlab-ci | github-actions | jenkins | tekton]")
+	SpringCommand.Flags().BoolP(jacocoEnabled, "", defaults.EnableJacoco, "Enable jacoco integration")
+	SpringCommand.Flags().BoolP(jibEnabled, "", defaults.EnableJib, "Build the container image with the Jib Gradle plugin")
+	SpringCommand.Flags().StringP(buildPath, "", defaults.BuildPath, "Project build path")
+	SpringCommand.Flags().StringP(outputDir, "o", "", "Directory the project is generated into, it must not exist, be empty or a new repository with a README, LICENSE or .gitignore only unless --force or --merge is set (default \"build/<name>\")")
+	SpringCommand.Flags().BoolP(force, "", false, "Replace the content of an existing output directory, .git is kept")
+	SpringCommand.Flags().BoolP(merge, "", false, "Generate over an existing output directory, generated files replace existing ones, YAML files are merged")
+	SpringCommand.Flags().BoolP(sonarEnabled, "", defaults.EnableSonar, "Enable SonarQube integration")
 
 	spring.AddSonarFlagsToCommand(SpringCommand)
 
@@ -130,42 +173,159 @@ func initGradleCmdFlags() {
 	spring.AddGitlabCIFlagsToCommand(SpringCommand)
 
 	SpringCommand.Flags().StringP(gitRepoUrl, "", "", "git remote repository url")
+	SpringCommand.Flags().StringP(gitBranch, "", util.DefaultGitBranch, "Initial branch of the git repository")
+	SpringCommand.Flags().StringP(gitAuthor, "", "", "Author of the initial commit, e.g. \"Jane Doe <jane@example.com>\" (default from git config)")
+	SpringCommand.Flags().BoolP(gitSign, "", false, "Sign the initial commit")
+	SpringCommand.Flags().StringP(gitSigningKey, "", "", "Key signing the initial commit (default from git config)")
+	SpringCommand.Flags().StringP(gitSSHKey, "", "", "Private key for SSH remotes, HTTPS remotes use $RLCTL_GIT_TOKEN or the git credential helper")
+	SpringCommand.Flags().BoolP(gitPush, "", true, "Push the initial commit to the remote repository")
+	SpringCommand.Flags().BoolP(mergeRequest, "", false, "Push to --merge-request-branch and open a GitLab merge request instead of pushing to the default branch")
+	SpringCommand.Flags().StringP(mergeRequestBranch, "", rlctl.DefaultBootstrapBranch, "Source branch of the merge request")
+	SpringCommand.Flags().StringP(mergeRequestTarget, "", "", "Target branch of the merge request (default branch of the project)")
+	SpringCommand.Flags().StringP(mergeRequestTitle, "", rlctl.DefaultMergeRequestTitle, "Title template of the merge request, rendered with the spring settings")
+	SpringCommand.Flags().StringArrayP(mergeRequestAssignees, "", []string{}, "GitLab usernames the merge request is assigned to, the flag can be repeated")
+	SpringCommand.Flags().StringArrayP(mergeRequestReviewers, "", []string{}, "GitLab usernames reviewing the merge request, the flag can be repeated")
+	SpringCommand.Flags().StringArrayP(mergeRequestLabels, "", []string{}, "Labels of the merge request, the flag can be repeated")
+
+	SpringCommand.Flags().StringP(preset, "", "", "Preset name, file or URL whose settings are the defaults, locked settings must not be changed")
+
+	addManifestFlags(SpringCommand)
 }
 
-func initSpringCmdConfig(cmd *cobra.Command) {
+func initSpringCmdConfig(cmd *cobra.Command) spring.SpringProjectConfig {
+	var springProjectConfig spring.SpringProjectConfig
+
 	//Mandatory flags
-	springProjectConfig.Name = util.GetValue(cmd, name)
-	util.ValidateRequired(springProjectConfig.Name, name)
-	springProjectConfig.Group = util.GetValue(cmd, group)
-	util.ValidateRequired(springProjectConfig.Group, group)
+	springProjectConfig.Name = stringFlag(cmd, name)
+	exitOnError(util.ValidateRequired(springProjectConfig.Name, name))
+	springProjectConfig.Group = stringFlag(cmd, group)
+	exitOnError(util.ValidateRequired(springProjectConfig.Group, group))
 
 	//Optional flags
-	springProjectConfig.BuildTool = util.GetValue(cmd, buildTool)
-	springProjectConfig.Description = url.QueryEscape(util.GetValue(cmd, description))
-	springProjectConfig.Language = util.GetValue(cmd, language)
-	springProjectConfig.SpringBootVersion = util.GetValue(cmd, springBootVersion)
-	springProjectConfig.Version = util.GetValue(cmd, version)
-	springProjectConfig.JavaSourceCompatibility = util.GetValue(cmd, javaSourceCompatibility)
-	springProjectConfig.ServerProtocol = util.GetValue(cmd, serverProtocol)
-	springProjectConfig.ServerHost = util.GetValue(cmd, serverHost)
-	springProjectConfig.ServerPort = util.GetValue(cmd, serverPort)
-	springProjectConfig.EnableJPA = util.GetValueBool(cmd, jpaEnabled)
-	springProjectConfig.JpaDatabase = util.GetValue(cmd, jpaDatabase)
-	springProjectConfig.EnableLiquibase = util.GetValueBool(cmd, liquibaseEnabled)
-	springProjectConfig.EnableSecurity = util.GetValueBool(cmd, securityEnabled)
-	springProjectConfig.EnableOAuth2 = util.GetValueBool(cmd, securityOauth2)
-	springProjectConfig.EnableAzureActiveDirectory = util.GetValueBool(cmd, azureEnabled)
-	springProjectConfig.EnableKafka = util.GetValueBool(cmd, kafkaEnabled)
-	springProjectConfig.EnableGitLabCI = util.GetValueBool(cmd, gitlabCIEnabled)
-	springProjectConfig.EnableJacoco = util.GetValueBool(cmd, jacocoEnabled)
-	springProjectConfig.BuildPath = util.GetValue(cmd, buildPath)
-	springProjectConfig.EnableSonar = util.GetValueBool(cmd, sonarEnabled)
-
-	springProjectConfig.SonarQubeConfig = spring.CreateSonarInstanceFromCommandFlags(cmd)
-
-	springProjectConfig.DockerConfig = spring.CreateDockerInstanceFromCommandFlags(cmd)
-
-	springProjectConfig.GitLabCIConfig = spring.CreateGitlabCIInstanceFromCommandFlags(cmd)
-
-	gitRepositoryUrl = util.GetValue(cmd, gitRepoUrl)
+	springProjectConfig.BuildTool = stringFlag(cmd, buildTool)
+	springProjectConfig.Offline = boolFlag(cmd, offline)
+	springProjectConfig.InitializrUrl = stringFlag(cmd, initializrUrl)
+	springProjectConfig.Packaging = stringFlag(cmd, packaging)
+	springProjectConfig.PackageName = stringFlag(cmd, packageName)
+	springProjectConfig.CacheTTL = durationFlag(cmd, cacheTTL)
+	if boolFlag(cmd, noCache) {
+		springProjectConfig.CacheTTL = -1
+	}
+	springProjectConfig.Description = stringFlag(cmd, description)
+	springProjectConfig.Language = stringFlag(cmd, language)
+	springProjectConfig.SpringBootVersion = stringFlag(cmd, springBootVersion)
+	springProjectConfig.Version = stringFlag(cmd, version)
+	springProjectConfig.JavaSourceCompatibility = stringFlag(cmd, javaSourceCompatibility)
+	springProjectConfig.ServerProtocol = stringFlag(cmd, serverProtocol)
+	springProjectConfig.ServerHost = stringFlag(cmd, serverHost)
+	springProjectConfig.ServerPort = stringFlag(cmd, serverPort)
+	springProjectConfig.EnableJPA = boolFlag(cmd, jpaEnabled)
+	springProjectConfig.JpaDatabase = stringFlag(cmd, jpaDatabase)
+	springProjectConfig.EnableLiquibase = boolFlag(cmd, liquibaseEnabled)
+	springProjectConfig.EnableSecurity = boolFlag(cmd, securityEnabled)
+	springProjectConfig.EnableOAuth2 = boolFlag(cmd, securityOauth2)
+	springProjectConfig.EnableAzureActiveDirectory = boolFlag(cmd, azureEnabled)
+	springProjectConfig.EnableKafka = boolFlag(cmd, kafkaEnabled)
+	springProjectConfig.EnableGitLabCI = boolFlag(cmd, gitlabCIEnabled)
+	springProjectConfig.CIPipeline = stringFlag(cmd, ciPipeline)
+	springProjectConfig.EnableJacoco = boolFlag(cmd, jacocoEnabled)
+	springProjectConfig.EnableJib = boolFlag(cmd, jibEnabled)
+	springProjectConfig.BuildPath = stringFlag(cmd, buildPath)
+	springProjectConfig.OutputDirectory = stringFlag(cmd, outputDir)
+	springProjectConfig.EnableSonar = boolFlag(cmd, sonarEnabled)
+
+	var err error
+	springProjectConfig.SonarQubeConfig, err = spring.CreateSonarInstanceFromCommandFlags(cmd)
+	exitOnError(err)
+
+	springProjectConfig.DockerConfig, err = spring.CreateDockerInstanceFromCommandFlags(cmd)
+	exitOnError(err)
+
+	springProjectConfig.GitLabCIConfig, err = spring.CreateGitlabCIInstanceFromCommandFlags(cmd)
+	exitOnError(err)
+
+	return springProjectConfig
+}
+
+// applyPreset loads the preset and sets the flags which were set neither on the command line nor by a manifest from
+// its settings, the preset takes precedence over the config. It returns nil without preset.
+func applyPreset(cmd *cobra.Command) *spring.Preset {
+	source := stringFlag(cmd, preset)
+	if value, found := util.ConfigSection(loadConfig(), springSection)[preset]; found && !cmd.Flags().Changed(preset) {
+		source = util.FlagString(value)
+	}
+	if source == "" {
+		return nil
+	}
+	springPreset, err := spring.LoadPreset(context.Background(), source)
+	exitOnError(err)
+	exitOnError(util.SetFlagDefaults(cmd, springPreset.Spring))
+	log.Printf("Using preset %s\n", springPreset.Name)
+	return springPreset
+}
+
+// publishGitRepository commits the generated files and pushes them to the remote repository.
+func publishGitRepository(cmd *cobra.Command, projectRootPath, url string) {
+	ctx := context.Background()
+	repository := util.GitRepository{
+		Path:       projectRootPath,
+		Branch:     stringFlag(cmd, gitBranch),
+		Author:     stringFlag(cmd, gitAuthor),
+		Sign:       boolFlag(cmd, gitSign) || stringFlag(cmd, gitSigningKey) != "",
+		SigningKey: stringFlag(cmd, gitSigningKey),
+		SSHKeyFile: stringFlag(cmd, gitSSHKey),
+	}
+	if boolFlag(cmd, mergeRequest) {
+		openMergeRequest(cmd, repository, url)
+		return
+	}
+	if !boolFlag(cmd, gitPush) {
+		exitOnError(repository.Init(ctx))
+		exitOnError(repository.AddAll(ctx))
+		exitOnError(repository.SetRemote(ctx, "origin", url))
+		exitOnError(repository.Commit(ctx, "Initial Commit!"))
+		log.Println("Generated files committed to the repository successfully!")
+		return
+	}
+	exitOnError(repository.Publish(ctx, url, "Initial Commit!"))
+	log.Printf("Generated files pushed to %s successfully!\n", url)
+}
+
+// openMergeRequest pushes the generated files to the merge request branch and opens the merge request.
+func openMergeRequest(cmd *cobra.Command, repository util.GitRepository, url string) {
+	token, err := util.GetGitlabToken()
+	exitOnError(err)
+	exitOnError(util.ValidateRequired(token, "gitlab token"))
+	client := gitlab.NewClient(token)
+	if apiUrl, _, ok := gitlab.ProjectFromRepositoryUrl(url); ok {
+		client.BaseUrl = apiUrl
+	}
+
+	manifest := rlctl.Manifest{Spring: initSpringCmdConfig(cmd)}
+	options := rlctl.MergeRequestOptions{
+		RepositoryUrl: url,
+		SourceBranch:  stringFlag(cmd, mergeRequestBranch),
+		TargetBranch:  stringFlag(cmd, mergeRequestTarget),
+		Title:         stringFlag(cmd, mergeRequestTitle),
+		Assignees:     stringsFlag(cmd, mergeRequestAssignees),
+		Reviewers:     stringsFlag(cmd, mergeRequestReviewers),
+		Labels:        stringsFlag(cmd, mergeRequestLabels),
+	}
+	mergeRequest, err := rlctl.OpenMergeRequest(context.Background(), client, manifest, repository, options)
+	exitOnError(err)
+	log.Printf("Merge request opened: %s\n", mergeRequest.WebUrl)
+}
+
+func generationPolicy(cmd *cobra.Command) string {
+	forced := boolFlag(cmd, force)
+	merged := boolFlag(cmd, merge)
+	switch {
+	case forced && merged:
+		exitOnError(util.NewValidationError("--%s and --%s are mutually exclusive", force, merge))
+	case forced:
+		return spring.ForcePolicy
+	case merged:
+		return spring.MergePolicy
+	}
+	return spring.FailPolicy
 }
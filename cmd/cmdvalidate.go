@@ -0,0 +1,78 @@
+package cmd
+
+import (
+	"context"
+	"github.com/rocketlaunchercloud/rlctl/project/gitlab"
+	"github.com/rocketlaunchercloud/rlctl/util"
+	"github.com/spf13/cobra"
+	"io/ioutil"
+	"log"
+)
+
+var (
+	cmdValidate = &cobra.Command{
+		Use:   "validate",
+		Short: "validate command checks generated project files.",
+		Long:  `validate command checks generated project files.`,
+	}
+
+	cmdValidateCI = &cobra.Command{
+		Use:   "ci [.gitlab-ci.yml path]",
+		Short: "ci command lints a .gitlab-ci.yml offline and with the GitLab CI Lint API if a token is configured.",
+		Long:  `ci command lints a .gitlab-ci.yml offline and with the GitLab CI Lint API if a token is configured.`,
+		Args:  cobra.MaximumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			filePath := ".gitlab-ci.yml"
+			if len(args) == 1 {
+				filePath = args[0]
+			}
+
+			token := gitlabToken(cmd)
+
+			if !lintGitlabCI(filePath, token) {
+				exitOnError(util.NewValidationError("%s is invalid!", filePath))
+			}
+			log.Printf("%s is valid!", filePath)
+		},
+	}
+)
+
+func init() {
+	cmdValidateCI.Flags().StringP(Token, "", "", "Gitlab token, enables the GitLab CI Lint API check.")
+
+	cmdValidate.AddCommand(cmdValidateCI)
+}
+
+// lintGitlabCI logs the issues found in the given .gitlab-ci.yml and reports whether it is valid.
+func lintGitlabCI(filePath, token string) bool {
+	content, err := ioutil.ReadFile(filePath)
+	exitOnError(err)
+
+	valid := true
+	issues, err := gitlab.LintCIConfig(content)
+	if err != nil {
+		log.Printf("%s: %v", filePath, err)
+		return false
+	}
+	for _, issue := range issues {
+		log.Printf("%s: %s [%s] %s", filePath, issue.Severity, issue.Job, issue.Message)
+		if issue.Severity == gitlab.LintError {
+			valid = false
+		}
+	}
+
+	if len(token) > 0 {
+		result, err := gitlab.NewClient(token).LintCI(context.Background(), content)
+		exitOnError(err)
+		for _, message := range result.Errors {
+			log.Printf("%s: %s [GitLab CI Lint] %s", filePath, gitlab.LintError, message)
+		}
+		for _, message := range result.Warnings {
+			log.Printf("%s: %s [GitLab CI Lint] %s", filePath, gitlab.LintWarning, message)
+		}
+		if result.Status != "valid" {
+			valid = false
+		}
+	}
+	return valid
+}
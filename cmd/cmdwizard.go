@@ -0,0 +1,249 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"github.com/rocketlaunchercloud/rlctl/project/gitlab"
+	"github.com/rocketlaunchercloud/rlctl/project/spring"
+	"github.com/rocketlaunchercloud/rlctl/util"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// question asks for the value of a flag, the current value of the flag is the default answer.
+type question struct {
+	flag     string
+	options  []string
+	required bool
+	// when skips the question if it returns false.
+	when func(cmd *cobra.Command) bool
+}
+
+func enabled(key string) func(cmd *cobra.Command) bool {
+	return func(cmd *cobra.Command) bool {
+		return boolFlag(cmd, key)
+	}
+}
+
+// prefixed asks for all flags starting with prefix except the listed ones.
+func prefixed(cmd *cobra.Command, prefix string, when func(cmd *cobra.Command) bool, except ...string) []question {
+	var questions []question
+	cmd.Flags().VisitAll(func(flag *pflag.Flag) {
+		if !strings.HasPrefix(flag.Name, prefix) {
+			return
+		}
+		for _, name := range except {
+			if flag.Name == name {
+				return
+			}
+		}
+		questions = append(questions, question{flag: flag.Name, when: when})
+	})
+	return questions
+}
+
+func springQuestions(cmd *cobra.Command) []question {
+	questions := []question{
+		{flag: name, required: true},
+		{flag: group, required: true},
+		{flag: description},
+		{flag: version},
+		{flag: language, options: spring.Languages},
+		{flag: buildTool, options: []string{spring.Gradle, spring.Maven}},
+		{flag: packaging, options: []string{spring.Jar, spring.War}},
+		{flag: springBootVersion, required: true},
+		{flag: javaSourceCompatibility, required: true},
+		{flag: packageName},
+		{flag: outputDir},
+		{flag: offline},
+		{flag: serverProtocol, options: []string{"http", "https"}},
+		{flag: serverHost, required: true},
+		{flag: serverPort, required: true},
+		{flag: jpaEnabled},
+		{flag: jpaDatabase, options: spring.JpaDatabases(), when: enabled(jpaEnabled)},
+		{flag: liquibaseEnabled},
+		{flag: securityEnabled},
+		{flag: securityOauth2, when: enabled(securityEnabled)},
+		{flag: azureEnabled},
+		{flag: kafkaEnabled},
+		{flag: jacocoEnabled},
+		{flag: jibEnabled},
+		{flag: sonarEnabled},
+	}
+	questions = append(questions, prefixed(cmd, "sonar-", enabled(sonarEnabled), sonarEnabled)...)
+	questions = append(questions, prefixed(cmd, "container-", nil)...)
+	questions = append(questions,
+		question{flag: gitlabCIEnabled},
+		question{flag: ciPipeline, options: spring.CIPipelines, when: enabled(gitlabCIEnabled)})
+	questions = append(questions, prefixed(cmd, "gitlab-ci-", enabled(gitlabCIEnabled), gitlabCIEnabled)...)
+	questions = append(questions, question{flag: gitRepoUrl})
+	withRepository := func(cmd *cobra.Command) bool {
+		return stringFlag(cmd, gitRepoUrl) != ""
+	}
+	questions = append(questions, prefixed(cmd, "git-", withRepository, gitRepoUrl)...)
+	questions = append(questions, question{flag: mergeRequest, when: withRepository})
+	return append(questions, prefixed(cmd, "merge-request-", enabled(mergeRequest))...)
+}
+
+var gitlabQuestions = []question{
+	{flag: Name, required: true},
+	{flag: Path},
+	{flag: Visibility, options: []string{"private", "internal", "public"}},
+	{flag: InitializeWithReadme},
+	{flag: OnlyAllowMergeIfPipelineSucceeds},
+	{flag: OnlyAllowMergeIfAllDiscussionsAreResolved},
+	{flag: AutoCancelPendingPipelines, options: []string{"enabled", "disabled"}},
+	{flag: ApprovalsBeforeMerge},
+	{flag: ContainerRegistry},
+	{flag: ContainerCleanupKeepN, options: []string{"1", "5", "10", "25", "50", "100"}, when: enabled(ContainerRegistry)},
+	{flag: ContainerCleanupOlderThan, options: []string{"7d", "14d", "30d", "90d"}, when: enabled(ContainerRegistry)},
+	{flag: DeployToken},
+	{flag: Environments},
+	{flag: StagingUrl, when: enabled(Environments)},
+	{flag: ProductionUrl, when: enabled(Environments)},
+}
+
+// ask asks the questions and sets the answers as flag values. It returns the flags which were asked for.
+func ask(cmd *cobra.Command, prompter *util.Prompter, questions []question) ([]string, error) {
+	var asked []string
+	for _, q := range questions {
+		if q.when != nil && !q.when(cmd) {
+			continue
+		}
+		flag := cmd.Flags().Lookup(q.flag)
+		current := util.FlagValue(cmd, q.flag)
+
+		var answer string
+		var err error
+		switch {
+		case flag.Value.Type() == "bool":
+			var value bool
+			value, err = prompter.Confirm(flag.Usage, current == "true")
+			answer = strconv.FormatBool(value)
+		case len(q.options) > 0:
+			answer, err = prompter.Choose(flag.Usage, q.options, current)
+		default:
+			answer, err = prompter.Ask(flag.Usage, current, validator(flag, q.required))
+		}
+		if err != nil {
+			return asked, err
+		}
+		if err = util.SetFlagValue(cmd, q.flag, answer); err != nil {
+			return asked, err
+		}
+		asked = append(asked, q.flag)
+	}
+	return asked, nil
+}
+
+func validator(flag *pflag.Flag, required bool) func(string) error {
+	return func(answer string) error {
+		if required && answer == "" {
+			return fmt.Errorf("%s is mandatory", flag.Name)
+		}
+		if flag.Value.Type() == "int32" {
+			if _, err := strconv.ParseInt(answer, 10, 32); err != nil {
+				return fmt.Errorf("%s is not a number", answer)
+			}
+		}
+		return nil
+	}
+}
+
+// confirmSummary prints the answers and asks whether to continue.
+func confirmSummary(cmd *cobra.Command, prompter *util.Prompter, out io.Writer, asked []string, action string) (bool, error) {
+	width := 0
+	for _, key := range asked {
+		if len(key) > width {
+			width = len(key)
+		}
+	}
+	fmt.Fprintln(out, "\nSummary")
+	for _, key := range asked {
+		fmt.Fprintf(out, "  %-*s = %s\n", width, key, util.FlagValue(cmd, key))
+	}
+	return prompter.Confirm(action, true)
+}
+
+// askManifestPath asks where to save the answers unless --save-manifest is set, an empty path skips saving.
+func askManifestPath(cmd *cobra.Command, prompter *util.Prompter, defaultPath string) (string, error) {
+	if path := stringFlag(cmd, saveManifest); path != "" {
+		return path, nil
+	}
+	save, err := prompter.Confirm("Save the answers as manifest", true)
+	if err != nil || !save {
+		return "", err
+	}
+	return prompter.Ask("Manifest file", defaultPath, nil)
+}
+
+// springWizard asks for the settings of the spring command, settings locked by the preset are skipped. It reports
+// false if the user cancelled.
+func springWizard(cmd *cobra.Command, prompter *util.Prompter, out io.Writer, springPreset *spring.Preset) (bool, string, error) {
+	questions := springQuestions(cmd)
+	if springPreset != nil {
+		unlocked := questions[:0]
+		for _, q := range questions {
+			if !springPreset.IsLocked(q.flag) {
+				unlocked = append(unlocked, q)
+			}
+		}
+		questions = unlocked
+	}
+	asked, err := ask(cmd, prompter, questions)
+	if err != nil {
+		return false, "", err
+	}
+	confirmed, err := confirmSummary(cmd, prompter, out, asked, "Generate the project")
+	if err != nil || !confirmed {
+		return false, "", err
+	}
+	path, err := askManifestPath(cmd, prompter, "rlctl.yaml")
+	return true, path, err
+}
+
+// gitlabWizard asks for the settings of the gitlab command, the namespace is picked from the namespaces the token
+// has access to. It reports false if the user cancelled.
+func gitlabWizard(cmd *cobra.Command, prompter *util.Prompter, out io.Writer, token string) (bool, string, error) {
+	namespaces, err := gitlab.NewClient(token).Namespaces(context.Background())
+	if err != nil {
+		return false, "", err
+	}
+	if len(namespaces) == 0 {
+		return false, "", util.NewValidationError("the token has no access to any namespace")
+	}
+
+	options := make([]string, len(namespaces))
+	current := ""
+	for i, namespace := range namespaces {
+		options[i] = namespace.FullPath
+		if strconv.Itoa(int(namespace.Id)) == util.FlagValue(cmd, NamespaceID) {
+			current = namespace.FullPath
+		}
+	}
+	namespace, err := prompter.Choose("Namespace of the new project", options, current)
+	if err != nil {
+		return false, "", err
+	}
+	for _, candidate := range namespaces {
+		if candidate.FullPath == namespace {
+			if err = util.SetFlagValue(cmd, NamespaceID, strconv.Itoa(int(candidate.Id))); err != nil {
+				return false, "", err
+			}
+		}
+	}
+
+	asked, err := ask(cmd, prompter, gitlabQuestions)
+	if err != nil {
+		return false, "", err
+	}
+	confirmed, err := confirmSummary(cmd, prompter, out, append([]string{NamespaceID}, asked...), "Create the project")
+	if err != nil || !confirmed {
+		return false, "", err
+	}
+	path, err := askManifestPath(cmd, prompter, "rlctl.yaml")
+	return true, path, err
+}
@@ -0,0 +1,37 @@
+package cmd
+
+import (
+	"github.com/rocketlaunchercloud/rlctl/util"
+	"log"
+	"os"
+)
+
+// Exit codes of rlctl, see the Exit codes section of the README.
+const (
+	exitError           = 1
+	exitFileSystemError = 2
+	exitTemplateError   = 3
+	exitValidationError = 4
+	exitNetworkError    = 5
+)
+
+var exitCodes = map[util.ErrorKind]int{
+	util.UnknownError:    exitError,
+	util.FileSystemError: exitFileSystemError,
+	util.TemplateError:   exitTemplateError,
+	util.ValidationError: exitValidationError,
+	util.NetworkError:    exitNetworkError,
+}
+
+// exitCode maps the kind of err to the exit code of rlctl.
+func exitCode(err error) int {
+	return exitCodes[util.KindOf(err)]
+}
+
+// exitOnError logs err and exits with the exit code of its kind, it does nothing if err is nil.
+func exitOnError(err error) {
+	if err != nil {
+		log.Println(err)
+		os.Exit(exitCode(err))
+	}
+}
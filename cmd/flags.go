@@ -0,0 +1,39 @@
+package cmd
+
+import (
+	"github.com/rocketlaunchercloud/rlctl/util"
+	"github.com/spf13/cobra"
+	"time"
+)
+
+// The flag getters exit with the validation exit code if the flag is not defined on the command.
+
+func stringFlag(cmd *cobra.Command, key string) string {
+	value, err := util.GetValue(cmd, key)
+	exitOnError(err)
+	return value
+}
+
+func boolFlag(cmd *cobra.Command, key string) bool {
+	value, err := util.GetValueBool(cmd, key)
+	exitOnError(err)
+	return value
+}
+
+func int32Flag(cmd *cobra.Command, key string) int32 {
+	value, err := util.GetValueInt32(cmd, key)
+	exitOnError(err)
+	return value
+}
+
+func durationFlag(cmd *cobra.Command, key string) time.Duration {
+	value, err := util.GetValueDuration(cmd, key)
+	exitOnError(err)
+	return value
+}
+
+func stringsFlag(cmd *cobra.Command, key string) []string {
+	value, err := util.GetValues(cmd, key)
+	exitOnError(err)
+	return value
+}
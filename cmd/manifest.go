@@ -0,0 +1,72 @@
+package cmd
+
+import (
+	"fmt"
+	"github.com/rocketlaunchercloud/rlctl/pkg/rlctl"
+	"github.com/rocketlaunchercloud/rlctl/util"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v2"
+	"io/ioutil"
+	"log"
+)
+
+const (
+	manifestFile  = "manifest"
+	saveManifest  = "save-manifest"
+	interactive   = "interactive"
+	springSection = "spring"
+	gitlabSection = "gitlab"
+)
+
+func addManifestFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP(manifestFile, "", "", "Manifest file the settings are read from, flags take precedence")
+	cmd.Flags().StringP(saveManifest, "", "", "Write the settings to the manifest file")
+	cmd.Flags().BoolP(interactive, "i", false, "Ask for every setting")
+}
+
+// applyManifest sets the flags which were not set on the command line from the section of the manifest file. Only
+// the settings present in the file are applied.
+func applyManifest(cmd *cobra.Command, section string) error {
+	path := stringFlag(cmd, manifestFile)
+	if path == "" {
+		return nil
+	}
+	// Validates the whole file, unknown settings are rejected.
+	if _, err := rlctl.LoadManifest(path); err != nil {
+		return err
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	// Sections are maps, top level settings like template-version are skipped.
+	file := map[string]interface{}{}
+	if err = yaml.Unmarshal(data, &file); err != nil {
+		return util.NewValidationError("%s: %v", path, err)
+	}
+	values := map[string]interface{}{}
+	if settings, ok := file[section].(map[interface{}]interface{}); ok {
+		for key, value := range settings {
+			values[fmt.Sprint(key)] = value
+		}
+	}
+	return util.SetFlagDefaults(cmd, values)
+}
+
+// writeManifest updates the manifest file, the other sections of an existing file are kept.
+func writeManifest(path string, update func(manifest *rlctl.Manifest)) error {
+	manifest := rlctl.Manifest{}
+	if exists, _ := util.Exists(path); exists {
+		var err error
+		if manifest, err = rlctl.LoadManifest(path); err != nil {
+			return err
+		}
+	}
+	update(&manifest)
+	if err := rlctl.SaveManifest(path, manifest); err != nil {
+		return err
+	}
+	log.Printf("Manifest saved to %s\n", path)
+	return nil
+}
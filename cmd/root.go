@@ -10,8 +10,6 @@ import (
 )
 
 var (
-	userLicense string
-
 	rootCmd = &cobra.Command{
 		Use:   "rlctl",
 		Short: "Rlctl is a simple CLI tool to make your application a great tenant for cloud environments",
@@ -26,28 +24,24 @@ Complete documentation is available at https://github.com/rocketlaunchercloud/rl
 func init() {
 	cobra.OnInitialize(initConfig)
 
-	initFlags()
-
 	rootCmd.AddCommand(SpringCommand)
 	rootCmd.AddCommand(cmdGitLab)
-}
-
-func initFlags() {
-	rootCmd.PersistentFlags().StringP("author", "a", "Saeed Afshari", "author name for copyright attribution")
-	rootCmd.PersistentFlags().StringVarP(&userLicense, "license", "", "Apache 2.0", "name of license for the project")
+	rootCmd.AddCommand(cmdValidate)
+	rootCmd.AddCommand(cmdCache)
+	rootCmd.AddCommand(cmdServe)
+	rootCmd.AddCommand(cmdConfig)
+	rootCmd.AddCommand(cmdBootstrap)
+	rootCmd.AddCommand(cmdAudit)
 }
 
 func initConfig() {
-	util.InitConfig()
+	exitOnError(util.InitConfig())
 
 	viper.AutomaticEnv()
 
 	if err := viper.ReadInConfig(); err == nil {
 		log.Printf("Using config file:%v\n", viper.ConfigFileUsed())
 	}
-
-	viper.SetDefault("author", "Saeed Afshari <saeed.afshari8@gmail.com>")
-	viper.SetDefault("license", "Apache 2.0")
 }
 
 func Execute() {
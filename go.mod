@@ -8,5 +8,8 @@ require (
 	github.com/mitchellh/go-homedir v1.1.0
 	github.com/saeedafshari8/flixinit v0.0.1 // indirect
 	github.com/spf13/cobra v0.0.5
+	github.com/spf13/pflag v1.0.5
 	github.com/spf13/viper v1.5.0
+	gopkg.in/yaml.v2 v2.2.5
+	gopkg.in/yaml.v3 v3.0.0-20200506231410-2ff61e1afc86
 )
@@ -11,25 +11,62 @@ import (
 )
 
 var _ = func() error {
-	const gk = "5806c95b6e385427edde11e01c89f760"
+	const gk = "313f1acd9e86bfc7d9826f5932916e6d"
 	g := packr.New(gk, "")
 	hgr, err := resolver.NewHexGzip(map[string]string{
-		"031812bd14f8971d94c3d49bb067a3b6": "1f8b08000000000000ff4c8f4d4b03410c86effb2b028a27eb0a05918122da0af6d24a3ff058a633691be926e324ab94b2ff5d966abbb9bd093c4f5ee28db80200c0a7b4a7e08d844f8b7690bf290b57c8e680d8ce87b5575ce6bd839d5952579626bd35f6226e88311685a64cbc3d81a237af52e78017f0151ca406468c60028a06a397d578b258bd4de78bdbfff03e9d75c2f37cfe319d8d80186c8790318992493ec0705c0e472dc588b77a96d4ed839f711d5c75d0afbd2bcbeb6347d3b8736c45aedfbf7f68cae3f16ee22b6c9aa7a5e2922948c481e51a6fc2ce671f0cf32b0789c4db416d9bc78b4d31b3af3a259357fd911c1d5c447f259ae2370000ffff8fb5461f7a010000",
-		"0dc0260be2c4c19a4b86c24c6c934354": "1f8b08000000000000ff648dd10a82301486eff71463d7391f421778a11b269144175397ccd4c9ac080ee7dd631575d1ddc7770edfbf2d654e0178eada8bf1899bcfb6e7d9a47b8348883828b9137f77f158dc6a3ae5fc159124794a8f944583f66c4359ac978507a627228aaaac95cc8a2a7c0cfaae832589543565ed2bc6bef0d1cdcd8e5d3cda668d0178a127831801f0bdf1ab7533e23bfedb79060000ffff85758b5dc3000000",
-		"2ebfbecccbc9fd5a9c704321d2197ec8": "1f8b08000000000000ff4c8ecd6a02411084df668eeb3d2001238484908b21f772773269333bddf4f40846fadd6574416f555f55fffc9ac9d36a550d6a4315a59206e29b8f3afc933cdb49e2fa7c1e368df2f4c59cdd4346490de9ca3f16ed1ef6ccf61db512979eecaefb3677d82ba8714bdae34fcc7d282937799b3a79edd23d408d7e30da8d2ebd82393ed829d651496cb9b5bd5bf72018ff90a8a4f5011a0e38e2e1ad771cb1e3a6637ce15960b4a74c7672bf040000ffff3d22f0c70d010000",
-		"339a0c66d644f38aff3f946bce4e0ca6": "1f8b08000000000000ff2ccf414b43310c07f0fbfb1401c593b3c240a4304437412f4e368747e9da6cabec2535c953c678df5dbabddbbf85fcf24fa60dfb062094b2cf315866aa4f00a4df2c4c2d927928c2e9f4bb0e8a2bd97bd89915f5ce198fd6384ab8c984a969b448a66d1552b0a0dc49c4b3770107ee8010131883a2c1ece9eb65befcb8aee17dbe18c2e372f9395fcc2013d80e41b0b0666339c0f4d54d6775d2326df58476b5ca775a47df1ef467ef9dbb3c0e6cef4fb1c27e3cbebdebddf178f3165aecfb8795e28a72e48413930eafe22e488886f24c9153a6eda4b3cdfd7983a2506887234a50fd63491ecef850b66ffe030000fffff7c4463747010000",
-		"4fcc82ee9a174ee93414e8aa73b83a53": "1f8b08000000000000ff5c8fc16eea400c45f7f3155e3d76093ca4b6b284aa2ed8748584f800337160d0643c781caa2acabf574c766cafad73ee0da917740e80728ec1930549e80000383d824a1a381942144fb1c6672a7cd2887035cbd8b6f5729562384dcd91f5c17a10b57976ae640de9826e9a420f7c87669fe81cf9fbf005a623cfb303e8c8a8c8a89e17ebf844dfbab3c7e1b7dc23b6ede6ff7bb36ed6cd06b7dbf55bfb62f93c153ea5e0a5e3dd13facf5f49c91beb3e79e942baec46eb3f1676614d3430c26a55834ca5fc8876357000b74c4b8b5e74203bde23d6a66e9a38757552553f9fb2a821bc6efe0b0000ffff4aea52e34f010000",
-		"70b8a59a53016b8dfd6a3ec6bd37a40b": "1f8b08000000000000ff9c574f6fdbb8123f4b9f82100ac80e6cfabd772a0cf4d09738dd66bbbbdd24eda5e88196c60a538a5449ca8d61e8bb2f6648d9b123a79b9e2c92f3e737bff943ba516d25b563db349125cb8dadb86bacd4d5ca8a1a7e18fb8d2f8df1395b8375d268966fb7fc8624fe6f8cff1c76bb2e0ffad244755e4203ba045d6ca6b5d0a2821af46333ffe5ffe1aff9f5e2c3e2edcd226adf8bb5089fd9bd284c61b274bb952b06df195f68b1547063b4b0ccdb16ba2e658c319425d078f0bd5d42b673912152dcfebb5dc2b9d12b5985f50e349a075d765ddaa569654ddbb03714e03bfcc6a0c8fd4803e39f7babd9b8eb7a17417a4f426fce99d616706eea4678b9944afa4d94bd126b71f3f4147da5161ae3a437560225a4166bd0e7a0bd156a34468c3b52a384ac1b45c40a4fa49e4adf3c6c4ef17beabcb01e6c9e2685a91ba9e0657ad31fb0ccd3c483f3ef0fdc8f5e66060de4638c22818742b525304ac13cc0b96fb5f47c2db51715e413569bb2553067391d4ce3c114742535e4694239449b68b17570f5494bff5109bf32b626f68e4ae98a2aacafa512564c28750bce9f9b355851c12552f38665af96ad54e585b4b35094b323390e0f5064bd85f7b7ff467f2f15b5d37086e0bd31eaf3aec0326c93ff65149d70df5890fb036c050862e4370dccd9d57e97282dc179a9292d11c64a2a180d44384e1344d0a2e885f082bd618d35f750788e2ab71660544a3b6739cf274c6acad49ce56767338aab0f0aa9a758f2f110d6f7da436509d02fc13ea4759c26b319ab510f3967fe0e98f4ac8822ccb4be69bd632b6b6a1288213926b537cc68f895a8dd9c7d7912b73c0c2c50f075908351685ff79746434789cc272c3fcd57fe15f9e9fa32c1ad6b688ca56aef2757b6062b57b220ad8cd82cac6c7c2ca477a0c10a0f91f53d5b962c3926561e2cb3add652570c13ea78961e31c51df84b6bead1114f8f49392e94b35818e3344d7a67d8f70fb5a2df04a8234b6a465ccf66974629f30371ac856a8149c70415031e3f2a935020d9ab6ddf64dd2c7ae813f450abf8c91f6a958dd324e9d22429dc9af76e574239347ce75f0ac8a812ec8b21a19f1e4897a60911bf40bb981e045028e1dc85b45084ebe08075377a724cdbbc304a41e14308bbfce02249284bd24fc22a4edc39fb12d64982792abd999d9de593c77b85d1dea25d8b47e1e42bfe10fef13844102ebcd380c3f90d78c76b2135174a855b903b5b5c48ebd0d04f823eb6119a9c9316b868a4db5de9cfbd1c76af05a4aab1a601ebe395da2f372c23297e679ce7ad55d9e4f48be237e33c3e279e6ae3938607e4d1c0c917c090ba3295d4cf79fe8002c3ba71a0fd0e9b68e04f51c3b3a228f053592a1dbc9c1c8a527737ba9a848f723561f475efe207d67afcdc131d362ebc217ec26a71b0ba3e587db450e25c8333da1c8215385ee8c294525708edd3ede5f4f5c99c2ca516586428f96adb0fff5dcb0eead19324f4f247e1ef4897c7eb00c7e5d4826b9577b4400bcf3e399e3ae82732dfcfabeb036f03f7f7607ca42dfd5e39e81e5ea2842ff4ca531b34fd313e678b5d38c39cefc4b02b87c4ca8d16b52cde6aa1364e92b885d64180e78654f0e948ff22e234d89c48d38cc407b115f5a352e5a57434d1b3499ce9dd7e54fc130000fffff200316b040d0000",
-		"8df9751018ac4c5b2a6f924e189cedbc": "1f8b08000000000000ffd47d7f731bb9b1e0fffc14ed21bd92fc444a949d973a6d3159599613bdb3659f24ef56ca76bcd00c48e27966c00c3092159adffd0add0006f34b92fd76ef1255ad97e4000da0d1e8df8d193eda2b55b17725f23d9e5fc31553cbc17030dc83d71284020659a9348b971c34cf5629d31c0a9e27bc10f902949ceb1b5670b82984d63c0791238409c0a906912b5e686580f1fc5a1432cf78aee19a15825da55c81c8b5f460d56430dc336d2f44b64a6f6155ea5dc824dc88348578c9f20587f5fae7a3f3d3a3e7af4e361bdb7bc9e19aa525073907bd64ba6fb409c0df640931cba15406d2b00215cb5cf35cafd77b01782d0da858e689d042e62c4d6f21116a95b25bb01d401620342f0c52e4352faad928331d96032b0a76eb16f68ab322874c161cd8952c751bb50a9886a5d62b75b8b7e79e4e16422fcbab89907b218638948a2df8a1fd0dc020ebfd783c67a9e21fcda7254f57f841c9b288f9ece5e9ab938f301729cf59c6d564e226663a89749c4b3d565cc3185e329142b99239f02f2b962b2173bba032372d3c525def5471b07f63b82c38d3880ba5914c226c100153c0b395be85b92c42cc2a0bc74c1802384ba120e3ca2cd3b60856625abc922c01fce28921dcfb2b3e37c85e1532e64a999984c436185a1a2f0d390383d7a797a0f46dca211531cf630e37422f711b133755c8653e66c9352fb4408871ca4a65a607179cc3abd3e393b38b934996e01acd84e6659a82e65f340d898b120ae48ae740cb7904f036e54c7198739ec2bce01cb444222bc455a939f5747461c92196d99ee64aab314b6fd8ad1a8b3c4ecb84277b991c0c0643785b5ea5223e844b776e57ac50bc807999c7663113805f0a6188ae223f2d41e9449676b2a37d08ffc670c6327bd2b82137434bbbe63cd172175c6bc4f29203ee65b579301eb33495376337fa98158bd2ec921a0ca1f13786b7bcc884f65335aca23ecb2b0e314b539ed83df21bd48606e0879a10fa57a5ae160d09d30c1251f058a7b7381051d28ae96517343a08fc9aa5a034d3dc409ec03b65c92566256217d7fcbde70ae0059fb3323534bc64d742165d3311b461e640e3d41739f2963c21f0099d0a3a727414ddac3ace6b7048cdf2b013f232cb3933760b668e7ac9bbe6f2facda79747af2e4e3e9d5e7c3a79fdf6f26f9d5cd8c804cd5962e6cdf02ce1405d0089ab9bf9e7d8539b9d73d8a07534f8c50bcb9d5907f13518c7057e058614fc30e6a1bb26e989c8c05134548394df99d3a125883c11b1694a032566e3e50aa93bc030fd62844dd7704672211e6e963caf3839ed8d66852612d4371296b7ab25cf714aa39f1a537ae93b6a495cc1b3c352995f0ddb92e6b89ad3dc29bb0fa9c39b4f47af5ebdf9e5d3cb7767c797a76fce3e1d9dffe5ddeb93b3cb8baee9e3e0bf98a92321d54880f67b979687ac4255c7bf071840c1e7bc30ac3a69718882c75c5cf37b5803fed96dc093d3cd2b96dccbfc7921b3be4350a78980a798b5f6f38c5e50fc8b2e7866585d81748c87ecf4d5a737679fde9d5d9c5c3e08a39daca67fc8fcba3aada87b5da1ba5268cf6b73e0454167b07dea1fb4c54dcda077366e02dab0289eb4999317b3abb25849c5fb4945ce9b2a8799fd9bf3d3bf9c9e1dbdfa74fce6f5eba3b317c1819d8b3c41d0bf66f257c3071605cb0c99c9c2e80b5ac282e7a8fcf58ed9e24483219c735d16b9825cea2572e44c6eefc0f6c0b4b7ea81a37be05f785c6ad49481812aafd492a7292809a72f2f0cfb2fb8e27a62fb1ae1ac562cb63cc2efa29270c32191f996362898a722b68c22e14a143cb13a2bc14965cc52c8e4b1a3f703e29dc4e22091e555caff4adc65803d4e5f5ecc465bf021ffa0b7f0076c39dbdec12fb50e33dc6fea26e6f0fe3d8c86305e68d8878f1f7f34d8ce070e79666759b1306b8f463f453f4222072172c51c4635e08dfeee6f084773cd0b83039ec7b2cccd97804582960bae97bcd8357c0777bce04a77c0b18a8f6711604c1fcbf99bad2d0ea2d11a3fbdffe9e3268268c48a45b4536bcc0d429abd63a30d526b10ed2599bff1f2eb98fee3f84f4affaebe8e3ffc79a7b307a08df0ce902244a3fda8b711ff2234ecf73efef1c741f78c7a55bcfef90c01493a5ef2f8b331af0cc1ce2e8e0ff69ff68f7f87d499e9a26c63f3fe89877ada6f3ed91acbfefe19a68aff0e530b99f7f7cecd2a574ffaa7e798c82c1aad59b118fa3e9ba81b287806319e433472fda3369bf8066c4cf7ff573f36ccdf241caaff78749dd85a837829213a93a0ca78899ce0108225fce98783bbbb9be337edc7a5f88e2dba8b722eb8369a70ca164e4935a2f9866f5d071c932796933bb6d90bb0cef0bf8ba4eea0a4219c5cf3e21625835e326da4602efd028cccb0fa1ca8a52cd304f517dc843b308abcba62d5ff5163db9b4ebedd5847f327ae583ca80f32a83094732b2869fd99fc0bd74ee056a2b72e3cbe7e850275074b1b997c6b54778846be4704510488f11d74019c9a9dcb597a08c746b4b1caf627f37e6a8c01a76c688926b5f9fd00c6e026812682327ad5e8298ce1a8728504725019f544e48bd4697695af2366b9d98284e50b5ec852ed02572b1e0b74a88939dcca12e568490e1ab650908acf46a75aaf95ccb89fdf8f5064302ee6b0079b4d9f2a65d6e97a6cefc0baa6d21c150be5106e3e1b15c5ea4e7ddc731aa82a63a388acef903ee34d077fb2438d9ed6761c5904023c88e02b5906d1681ae16e9a0ed160d3d8c20b8349678594b9165657118ba54683529b03ac602ecb3c99001ca3ceacbc1b7230b4d661cdbacb7876c50b6bcf01bcdfff0833bff7d606b6c0a9c1d43438b1c36dc7325ba5dc58b56cb1430d0e42080c552f0f60608f2fee97dbf29acaeb0dd0486996272c9539025711a47221e280729d33ca2bbace1670cb0de9d8d1afeb54ad69f40cc6703a87f13f772191501f36741e5a6ccc452ed4d2d178070dbe1479729227976cd1a040b77905cf98c8d1811eb33ce74930e8f35bb45fd9c252e6211870e42e3468c05f6db75914596abe58a5427bf848545bebf5166c6d365b04e86629526ea8381aad87b621f115a37e4f916e03053b939785c87e590aed4c0ab6305d5dcfe9c750720f0fe19c2b6db0533570f8bae1d63741db2d2acdba6a3b33b36d80afe60e4e231e2189d765ffd670eb097c85adbf6f75488de1215ce0d8ccec0f2fe02a95f1e756338fcfd1da7edc5473d9ffb869acbbd9bd0f599a2d0ea79ded2b120936cd0f7240b682668b08a254ca551bc4ff70c69e0267b5515bed9a027a6baf07cb862130c22e8c2176825796455b51f85674556bb59f6a2bed5020895d5b04ce66108d9edea3389a6366d8a699b0670d5d0dad24f8a799ef33e2f7f0c30f90c9537551318ee671f6338f5adb6ca4f53d1aedf0d0470d58aaa411b5751e358638e52c8772658eb9c5ea5de0589e4026af39e8a5543ce80457b7d67d862c1811c8537457f5c26b2c76b60da3e6fafb35a7800554a47c385a3720988daed37488c6c3fd8e1ed38f9b0e5af2936e9d9f4e20fb7702a96630ab6deb37cdc66904cd3f1219a8131081e5e44f3c32c2cde90a5d643525ee5123b3ce11ac36d93689baa674c721fc4d394c377b39b5a18d3405e2e35e1476cff1f7668aee236af981d06cca610f3294c7e04c0027e15f88046d18f4381a0e14a7d269c3837b90bfef56f2207af178414b216a2b992f9dd3732e0aa58d3dc8bfa0df18bd8f144302a32de17c0dcbdc05c5c9453e18daf65ac2781a286a2fb8d22267a8dfb59435ece294b5bfb25ba559fcd96b6b9c2729bf4bd1bac0393514ad9554ce0e21fce1e7d9687df0f8f1e8e913da521411f4c44a8903c7ce5752cdc6536371994fa3f5909a6dee44b4c3f14a7628ef7fb1ae62609048ad7902b95144af98e209c81ce2b2280cc9e0b67ed1c8a1352b165c63c3009997f4abc723866ccde128b82a530ca7567aef17eaeed0f9c2fb7b6d677ad883dd324d2fd9c228cc1ebda1f83bb0e2efeb5742e50162f1c9e4495bd4de87b2a744c235c7ca7d7dd6079bc968fdd452ff5cb44999eb78c9ebc11b17efb2219930e07a4c762a8354280d723e189aa65ab05421ad92fbdb126d2d18a474222a8b1a600c3f87f8c5288852629193a9e3fa5eb1f83330daaff14f362c071497db7671c09dbedda91c063d46868b0e5a32a283409f6723326bd552cc75b0afc634f8c95a041d0e790bd9581d3527bd1fa9cf531f28304a42c153a6c53505d815dcc8e233e151e44a24bc1153ad01f23318d94fd1d67afda7ad68e4a61079060ba19fa54658997c255982c876b8da4bf8f51e6e63cbcbe264a12347c2609b26ddefd5d45a04799a2768125b76b40bab94c52e5f42d887943873c51722470bd198aac6621e0c211546df5b320d4b8c83f93ca40e9b38e962b918b2d2de2160da19989e655c56f3705e1ce21b9755c40e23abd4a88f34699daf0ce43eda2cd802e3c929cf0d8b3d33ff9c5b0e06ba1059c613c23bfde6690e2d4dabba7258f142c8c487645d58bd0a30da9016cf67a3ededd17af874036398ee908ee1a869b47e6a94bf94e79b411f97fb4686e6696050291781b255f9fd2a114678a8bac268eb43bed5d9ecbcd1ac68dbf806181de4f13464d2a677f07beda83a26d0e8db619db8fd0bd45e83415cc17f40d386abf0ecda562d2b4daae954ef1fe3fcc1639cd7c708f55a32428f97ac508ee0201ab94123a01c19639d41048865bd455b12621ceace410ba8c7d80c5674508dd4393b47f523fa10b40e16f020daf916fac1036694bb50c4f13cb1f1e79cdf1876815e32a3fe111798f4d10fff0754aea64d374a867041be609b4787f2e443f1a1de2a007c4e80a3d1f636ae194ff31dc6bde9321b4f9b9ef841a3cd59d8267cde1efade353587b48eff4ae3ef263dbf215d943768baa1fb29ad929107151b0a97d5a4ad3aafba8fb351a70eef34470f909735e5aacaa343cdd6f265ccda2be85be0f70d44d8cf75e59444d3cf2c2d49533df9c2b295510cc8611d5b873fa0a8718918f7acc2e7e952e6c9a60ec9c84efc85e27823f33d32b47a43e992b5eebd2210c7f3f28ff2fbc6d73801fc6555885ccfdd4fb0f5586da112dd835927b331d4d2c07285d32ac2d3ab8e56fa265986d6bda3be15b9d92df69f6da317eac6ec75c1f9cebd5b50b7472d14f4443f642fd6e607f25b57fb519bc1bd7b8203f66c8c8d069dbebcc0e46c6310f83c26ab0099e3e2bcf7e832938505e25383e6b2b0807c3402542a62ab3fa1c65bf0052b92942be54f863501cde0ca66d2cadc02ba626a094f27e015e9846b26529ec0bc2cd023c2b46dda91273c178960f9de62318e65c1f756659aeefd9134630a3c6d5b6a8c4e5f5ecce0b19a6d7f883e8cd63f1d1e6c3e443b98e1f3f81f91dbb5d39717d14e9b505f70cd8bcc28a9c250e2425cf3bc3b0b947f114a53be9d9883d0208c0e1ae4cb37d5d92e28d8ea39774972e92da6c9cdcb74027062861673dcb3584326136e9046a9a4c92e0d89426c297359f0047d1746c2f102ae79a1301f50cee13955331ce5b794f4a648018f0bb932876fc90b9b2567605d99c315b372b1d4c04a2d33a685a164bba2c6e1ba66c56c9b5dc57464c41c9dc8743aae5961b9ba3d4d44fdde0b6c1115d51fbf669f39a8b2e01853a57c2f16c7c248698cb61a01fb6174cd0aea3817b5a3b26fe66008916c39420fa6daedc294dc6e3742713c4aaa7e88be27f7ecc6e0f1866f15c6e233c6caa29e7f76c9953e4761e3a5a6ff6536da4e789cb282c378455479f02734e2f2324d775a461cd9b62184c3fdc3e9fe86fc3e1e1473fe9f9ab2fec0ce47adce56dada79dc795a0cdae9bc38e43348f85ce478c0fb63f6cebf8091eb7ff70391495917340daaf7980825853d371e239994f5a363a118e09d687dd859a806ef380c3d0907aec72ba1aa520673508832c2c72141bfdc693fde86d13afc616fcf379fc3de066c1e037a638281c82353eb89b2b3d3ec0b3b5a87e8b447b36e450e5a3aeec3c9de662db4825ade351a84b4862ea68c99da985a60411041bd654a71653d6b1d02db28097989090f982f8e991045c63444cf4f5ebe393f81a3979727e7113a5b3e73880efe08d3fdc895b351023f7914098c39111432ab62fa1670445335b0ec44b60ffeb8438edd8efe46592e44e67be3ea22df75babf33190ce19d32e719f7d93ec9582e56658a9e9ec30e255a4bd4604c8f15a33c062c78b9b25ef630d5c722d7a2d4397feaa91cc1c367304633650f2d96432c47590ad5766005e6e49fbb0f7e2d7a7a7e72f1eed52544238b418846161b641a55dc7d1fbb53fb4f47e7e7477f3327c5f6dfa9e98d040b837c617b8cf04d2693d11a87683ea5b05d9f42194cba71f411d8a5b5ed6868f72d5eb2a26d07d6dba033bdd30e6cf6ab0d84716eec407debddccc0d60d561bade612c336ebdaf3c391f975e375e3ba7bc0d24c221223e239161d55be829a73d98089e0d1ccba51485ab69e145b6d9e3374c7c60817a6c2fa4ba4383b3aa59950f42a48f70bcc661a88fc618f60f4acd325617e22b83eb7b0ce9b265dccb065f03b7bdbe234dc29a340f421948e5922b9aa964475170ea9a4376b72a7671ce9f17b116d9fd490d342fe3720c422e3a08681fabc66add1a30676a687d38d6b9777900362d482ab3c5d4d4f7d9f07a3e304ecec80f70fff077a780cc5c3d87c0c67b6d9e9307bfe4b8a1cf64064abd4685555ba9d33c0bb0242ae3ac9ecfc7f4b5448e22a51ed807a19c8bc403e3c1e3d81319c585bdd4030bdfa3893e9d8e048384881212def67ef0d0c51e3d9e8207418d90c460c1ac1d34ae140905da19fa6a389a08e4cfba8a12e3c3cbad2e77f3ae72ca9d5f3b1b04ab9d3b7d4dc07d3774bd5f7a12a9633ad53c9923e9cbba0524fc823e5b93bf0184d71b53c054633289ca30b26522333ed415713805f504589c5557a0b2c492c088c223b075b18dc2cb8e2c535654a7828b6d311a9b1da588872a54526fec948a3151a6e5ccad68d2cf4d2c054c256f9e3141d8f31fa3c85a70b5ec5af84723caa30fb904ab99a0cea41966dd36f3c26d9f6c30f2498b7265b6d6bad0ad778476b57d026080aa43cdf98a9d9d050ca9436087a183fe88dd7bda5d44f60102fcbfcb3ad2043541bbe1ba8705a64b459e49092a55e9586809ae5d4e48e3b6e06a30bbec2f2b6f4d61dfe315c62ee299f8b2fb01d07a1fc1d62073f59e788a2d252c5f10c561e571532943e8295ab1e627503daff3f67ae66cb637f5a7da5e78e59107b38e8483aad47966bb9a641aa811fdb6e0c418f2abf29d452dfbdd7dc37b78a936f5b05b991ddb4361901b9d445b397fc8bde45d7a20bcd3a76d9dacc313c0f7b9121cef285f75a1f5b971ef28f6d721b5b067476f4fa04d927fa41d6ebc966031967b9da717a372a7258e89bcba6ba654edcaed5f2bc31dab7d1b8c4c057f3bf395f992999f555977164f20959ef9aa23bf29a17e860859cf324481a51160c96fd5b73c51a23c412ac3f191a99f79049425755e090498721a34d3ff7364326cff8175dffe5d2a544fb4e8ee46a7d8d98b213ec489c76195a618dc5f4ee5c69dff45bb2a571bae4363aee4a396b2d302c8c049fee4c603a129e3b92e4c09ce75d10f33da32bed8235f82ae9b02c64b95856018236840bae953b7fadc799acac9da33495373ca997aa3410158d6afbf280ace90069973da9c043a0fdab4a4f7cec5fb305a54ac151ad3ed320010b7a3b80f902f376fda62bdb20c2eb983b5bccec4c1f3f8627ed2cc14613b4c33a9bd1386bfa70881477c9169baea641dab83b4cf5ea1f9a6e67c679c0621da2fd490a7ab6baa283ed922beddbdc95470d174b79037ba801c01e51e0bd94071d7ebc070c06940f726c551558899563d924810d2b0b33a5ee82a24874a62cbb4a189a5fe4ffa60aec7a01f69f7b01f9bd988db6ebc5487e4144e0b87998bc5955fcdc55b5db55ea15ee5f5de6f54f2b18bc372f98a7b560c40377822249a45600468f82557e88e08385831f3f8cd68fecd1d81876f121ea9bca9dab72586923d423a6b7feb0a38cb22beff9e1a86bd54afca98f4fb3c4a516c2185c8aa7d122d07af1f971469db8493a66f4d676fe16f65bc34a07cca63c6a88ae3bf012b6dcfffe1a1238ae929ea9206b2934e91742213fd9c55222ba1d8ceebae980f29ae978e92b09b1401f0b3b445ee39fbfbf786b2dbbbb40c9df5aa338f10931477248806ae4024e243a2e25f89790caffdf04d3a36f104d0fe216d66f7ad751f98d99c4a39ec320330c768fddad4e4ec5a9d2b2b428787adbd1d76c20e526a83dcdae54e8d78f09eeff7bf29f74ae32cc7acf356c77660b0441ad369070e22f782eee9e775777a3b0b448ae77fb5b1b38dbea5c195d5898f0546442f38e2ab821c650312225f3715082a5f83f4a9ec75c81e22b56e0bd3757b74118acadacda526b1b4a238a49ba74dadf9bc7adbba9f95dce55cc563c81312854e0650e9bcd06195ba7a6fb3fdcd4da71b46ccbd8772153ea1ab5651c063c6c6b13e696579dee37fdf6bb27f9af68603c9019f763bca9377450c399bc01654e9dcfa3eb68f47f4aa9b9f31c2c7951a59dfe039f080d29d3bce8a47277a4adbedd580195d737bbb568f9877b68f9b7a7daef92bcdfbd63fd58ba17379d88399345c6d2ee84af8085d32513bf39eefe8d0fd3bf08f5bb8fcd9ac69a55d0321482867773cf9ef2c7ea228c26c3ec77c75be389fcb0546dc29a37beac56a9a07bdca830c4684bf58e283215a6b763c208a65de01d93de66c22b65ae78cce8e2455e45603148cb30b7c307429d4d47355eaacc783281cb25d3be9b508097d7886c250bcd72edfca79948b0ce3188314ce0178ed373b3138ae683512b7b1367783d853191b6d46008bfd6f6ec577f0a779d93c35fdb6c4c8ccfca9855e61ba5cdb81b3e6b191c36b0c6f2a4b6e06a7033699197148fb891c5679b9043110c96a695fbf881d54a1317edc43b4944ad8e6f17af21112abc87b1ca44695d6524e66e895de5555511d55dd93094e676c3c818bbe5dadd79ed6ba42ae5dc25c7f466cefce1fb326760f49f415021ae1578f6bafe0d4976266a56ae7fe7f65f15fcda42c644cd9e1b018db96763a2aed8abe5bfaf5c1441624f26dfe55434e2aabbaca3a9d626f882b1c268f4ccfcf387a67117b69c6dc3a8d6b31250018339c0f49fb05da3c0df2d6766da76b40c1a56faceb366cb69bd65c83fbd37aa519168870dbc60e105415dc33eebe3d07fc0075585594ba9f09b178dd64f51abb0a47152f93a7d783a8c29fb1ae59b842e19ae243d42d81e5402ec348828e722b6b1768ccefe9a88c28cff2b022931fae695037b3ff06e00eaaad478f8b61424623e1771996a8a8bddca72abe020f3f4d666cf56979b57919404a2d1b61d9302d0150ea21dbc57ab71e35a48a7b368b43da80b585f3e5a91394a2f0774387cb2b7e9820b4d3784ed8ea6d67f5a5f65adf5105e8a2f94fcbf6479925ab6e0b68752e83f73beaa7207faecc4eaea71da07eb120b8a35ebed3d014e2afaebc15600a38613f784148f0aa75d54eefbd1c39d60204b9ea7739f83d4cc3fa23b659be91375be0bc2052eddfa5d2aa665b0be10c3c971141849c2935d83d47809fa7645e9cb1690cd2cad32b35066e79cf40e4515514a2c72965a212e1b42db02c268090a18ba583de4a528cc0c4c77efb961f29419cb164ce436bbc342ba5356328dd042ae7e4f7d4ec385577306b4ea948cdec97a2a3e6cddbc8dcbd42a95f04d0bb5d0fa2b9109bd67b31f587e1b88aa52f1e481f521a1d1d10cc8bb20a7bbd4ce470c6d205bcc0dfc5ade779f8835cbee2984e08a37a2e64e94fe17a57c65d2343784af6af230081a4d9b62eff8cdd9e5c9d9655780694ab77a8c9e06a1a48adfd88e51905dea5bf554273b8ddecfd20e3289da85d241abf7d38f9d55d38db0526b69e0c24884a00a4fd16e04d187d17a346d9b7f210ba1e6017b69868feebbe8af36d34baef4cfacb8e0ba6fb2f5f1d78f9a263a46d2c29b036b57c176e2a806363a096fe3368aa7e2fa10cc1e745d22da216e822897fd58c3c9105edaf231555ee14d2c9c82260637e4211cd4762672cbc5185e7dd7f73f6ede37e9e0f1e3c9934d15dfebb8a6c306f42165c582fbb47677657ab320aceb2a1977b82ffc0d05e86374871b2f80c3508a73c63afdfc244f6abf3fe0da0d9c6dc76d334550a964f31eb7e9a0edd8b3542fc136f440ed5c14e269544f965d4915c138e79d45f894444877f454d337d60946bb82738d434c3fce82e1b0349ef25c9f069aaa7f5e6b4b25f79bce727777035a0709dfb15e7b3fd5b386e3e341ebaeaf5ff158e6c99d08682c8e6eeaf2130930f1aceb7eae0a7db55e1627d13d27edbe84e47a25ac05ff5397ebe3af46f7e3f59b67f0a6db461a36b877e6b05c55699bc3f082b76dc3455c66e88eafa7410dc9284441532cb2a92b53832135c591f0f2dd5d604a9519de67e23518e7ecf0a9695447788fe51ff964be2073f7b7b2c969e1467dd8f9bdccf31e8ed10cb934b80796fff459c357f6fac03bcce02b77e9de55e3aabd96e17bd571a5ddbdb72c3df31dab2bec6a22a4d350bd0fecb35e71d04bea7ebf85829c5ff322bc21369a009c19359225ff5d2a5d657f652ca14b251cc93788fcdf8324efa32cf2987bc26a6dc903b6a3b74e4f85a5902e2ba1a619e36bd06c1937fa44ab377ef4bc890d5f10d27e7708fa63a9dabe06a57a6f48ee5f53e42ec3746f0611add71621da5e90d7bc0c35714c5597a5b6f9d5ce0126e646502961542d664fbcb3bb06432ab1c5caf6fc9612766c15bb75e186de90aaf423fcfb5673a5d5dd592ff4e6936dbc29900c999dce77b13cec653b58adabd82df4bfd4c62a65346eb0030157ffd62af23e22180c2d19d8d91516eeb409d74ec31c04a3a8072618668484a5b02ef7b76158b58bb5ef335086746786a2cb2196ec1a3dca29674ac3b475512a29ccefdf93ae3c1cada736d7cda7c147ad62f386767e3aef3d26f63cb8ca09871ef732c400482def3cc45cd5e47eaba86ec584f3a96ec1a58ce947d3b1ad8db784522b8b9f86570d9d74be1612914be5e34439ed49d0181dc5fad5253755fdf11d77548468a13bfa78b20bdc16cb5b8e667ff7fc65300c394c775d11e2b27e32309f7f7b7fa7daa96a6cdccf69d713bc5ac4113959a39ed43dbe9945387eb942ccb4183a6605a172c659527714a207b556a272576026a85159f2da457150d39bf08bc8605e481bbd78d6d382e7093eff0396b51c2f59c162cd0b5f14dd27007d196c53a562f167efadc6e1a92448fbab33ab2afc7ae15b5caf2cc0beaef0cd40757e7703ce79eba9e2e559557e442077fd357f1516d0fdb6eb178d5f5b2507b18bd63e323b6a468a9af50638bc6b58bf8cd12eaef72ec6114de4871ffc5aa3d1da7edc1b8e6cf7866d33428c76f779dcd1c75fbcf83d157e6efddd14eca8179db76d9f37d503b0ea7587bd2f0bf0f7a6ba2bb82a4a3e68d0763ff5558195060916f59b4b7cbdb6ad25428d1e1902fe43f5b0fe7639bd05113c4c73eb2b87b459a564b3e08b62913a323901785ba6a9d56f7cf5048677e9fdb9ceadcd83b79f05ef0c3d6639455cf01aa31b2a0d76afa170f117849271e57cd1c69671351bbbd6f1ce34c159b2d58ae7f69d86381abe35244df112122c2bfc75b4ffab536368dea256d659d568badb319baf20eddb447c1b96dfbb45c157b0f5f7e1de969574cdd7c36d22f80a71a9611cc3b371a56c3b56c0357c25287f7ffde6d3cf27e717a76fce6676772e982d34958558881cebedb28ce5c996c2bb50718768af30b963d031815934dac688d6faf9d1c55f3f5dbc79777e7cf27effe3e3bd2761c8e94758dd243b7bcd5614991a04538b0e26fb93671112cfdcbe8f36d9c542578cda071532d8c2d64324bbb0e2c55c16443dccbea59529e05f563cd63c990c9c4367dfc9a6fa5cc2cb83c983dcf13cd0bf32890c6d301783ff1b0000ffff7eb64a08b47a0000",
-		"a457dc800d75dfc09217e62dc409d256": "1f8b08000000000000ff9490bf4ec7201485779ea2612fb476314dff0c264e755213570a8492c0457b51787c2351d3e637f54c77f9be937b86397b577de91d6d8091b6aca1950619940533d2d797c7fa9ece1321831251ac02f5c326c0e8251852fd267b07d867b423dd627cef394f29b1d4b1b01b7ed7342d7f7b5a9ee5a6bda82d601420353dd327d2d98f4ffb535504d93b0ec8d52a4bb10be6c0a2edb1889720452c1f5c10fd7bfe72813dde75c75a9651d1891032f09ba5a6ef000000ffff2ced191165010000",
-		"aaf5c1dca6dcb7fb692bb04101b766c8": "1f8b08000000000000ff9c564b4f233910bef7afb08603bb52d291f6d8d21e320c3b8010203273662a76a5db60bb4cd99d9089f2df57fd48fa91ac90b62fb1ebfdd5cbb910e0bdd112a226976ead4912ed56942542883eab21549f038b99d8edd207b0b8df1fe94b08f8934dcd5a20af919f98224932fb7d369b1da93714e27e9f7552c471bf4f1274ca93763134ae425146459b9e6374b034a83211b9c42335a00b3aea3566620526348c02c1c4e27fa9360c59f2919a04cfdae58d350bba17d20697d76ead999c45175bf3c96ea75702df457a5dbbbd7b9ad78c365717a222fc71e7e12b04bc22b7d279c9759227e2462f911d44bcf3302f230dd87fd6faaf1eba0042419b69783763040a225405a9ab71e7e15b7befd5ab38b8eaacb5e53d82ed7fbed8062dc14c436488986f33419ca7472be99228a696149ab4b1913eb52a0ff575d1ea2d223805ac6ead37032f4a9929949132e1c8e18055069c3adc4cb59ae6e89021124f2d78af5d1ec6d83d93478e1ac310c57f00ae5de30a4a135f822cd0c2b9eeee27fca597f05a6054f07bfd5eea2adbfdb29b03f14c57ee7663cd9e67c5e4ffd11ce218a72c50be5d15e072bca7fc9eda411df6b83cf033210d84e02116995ace2c84889c7ed8ae066d12166773b0dba153fb7d72f83594e7c73e5969839998ad816786f2d95133359437e0718da6035e354e33542b068b1be2b77483cb4c5c5e3f3f3f3e5f0e04bbb29db025d93427ca0da6167c48bf23cdbdbe2217f1237e2efef87613a37fc6f71243bc01a70c72a795847a3d35517be2d8df6ccdce6a8d7ac610063bf2fcbeb1dae28fadc79089cbde6a9dbd0672933ee1c39ac1fd15d610246b1f2715b25911ad694e1f878337a05d0335929510bb60182d457cd1fea5405015c42f1fd315f10658a1aa4e5ffab353afecf3a235f7cbc9825b54eb52c7eda1dd437b6f4238edf283fcb1b506d61ee7652cfeea8f0e4145e90049a3ab6d3b98cd8676abce5117281947f2202586f083ded0fd643de4457430b65f85401da82eb7814a96a3955206e479a3f0bbaee0898b20c96326c8a3d36ae8bc0ae9a17e639b185f6aca1958950774b16d927a6cab378bd8269f8777eb56741295675c21d749a9f8fd85339eff710fcc7f978c7359bda6df34a38cc4c77eb8105fb787ed32115049a6508baa83688add337a58e05b5140106b30258a5fb9a125985f93e44284d257e388aae5e9201aee44489726179f7bf8bb914f6ac1f6afce507adc6c53ad4e4861d45623f4df994adf7b829a6a4eeb6a4ecb2af56d2eff0d0000fffff6592cce83090000",
-		"b349944b2e8081835cc6af954c90cfb8": "1f8b08000000000000ffc455df6fdb460c7ed75f41a88fab1c1bdd8ae0803e6471ea055d12c34aba02c360502746bef67e8d77562304f9df0759b12769ce908701bb1749fcf8511f7977e49b3770ce84914a281a58691975825e7d260eca5901e87d38a96705459c25df942d05ccc96bd718b2313114b1c4882201b06848c0e3e3e41a0d3d3d3d5b82474902e406391267721b6202a0b1201d5a16b47f18d2000a462b37020c86489c044fb2f565f25a490c02de2500213246aa9a2e4a6c3c095839ad95adee7c89917676ee5b3a5700830f77166b541a0b4d02a67fdbf32d572460960044325e1f58fd54dbd54fe18534f6badb259d8da82c718f921da9d97e2983d50e4a1f1fe1f2ea6c71b1be3ebbba80a7a774e0279d31d8eecaefe957ac31fda3872157a105b2afc8e95b689f356245360a4bdf99b492933d343f58c8d68a9d6df7f7836757a66f61804b67ef5535b9579a3e74ef2707ac317a17edcb17f1c35da0c56c71de7d9b871fabf60dbddffdb22fd33b8ebdaa749539d46be9380a389d9e4e7b1e4cc16d59d288c6f4e796c23858bb77c67123209d4d7e5aa874844abf15904e27ef87805646fd4ba85120262c95a51096ec0a1ab23631fa05c571288f7123e00465dc62747cb221d47133763a963e80b22a2ad473d2d8e4249d2d8380d974e8e489952b0ff0bba49f5c4dffb3daf7af574bb61e9f8feee6e4cbd5e5f562bd5cdd7cbcfcf5225f9f9ddf5e7ebe1889aa516f4940ba3bcc47c3cc7f5e2fcff2fcb79bd5fc18f7233b332e074020c9143f51b3a2fb7fa2fb7ed86f7cce10676591790ce1bbe3f208e91b35028ee23dadbfdce4b7eb4fa7f9ebb576f7f40afd6be59645d6715ed20865916d5c88595bd524c9b22c791e0f3971ad240da6483dfb4f46053d781748ec8e68373ba4a41004f86da1953c0c8a5e47d997ed4001f0eca2934e0bb83d5fee6dcf07f7f93322571407ad279026191d1f1d5abbfcff0a0000ffffda6feeff4b070000",
-		"c0c5ff425efeb1c7fcc23cd029fe9a4d": "1f8b08000000000000ffc455cd6ef33610bceb2916faae9563374510f0e6c68e6ba4490c2b490f4561aca88dcc84125972a54630fcee85fc5749758a1c0a7c3cd93b33abd91531faf60d6e1c21530a490d4b2d590768d50b39af4c2100adf517d52821c651f0ae8a54c084ac36754e05073931a6c8280280027312b0d90c1e30a7edf650f1162509906b744c2e92a5e7c8731600684c48fb4609cd53ba5280c46121d70272f44c2ef09664c37564b592e8055c06009e1d3265f5be0bd796042c8dd6aac89e6d8a4cbbba6b57f654801c3f9e0bac50694c340918fe538f4b9791805100c0945b7d52b5c76d4e7b844fc638fa6e8e3405a32ac8b524d199bd1d8fca31db41e16603f3fbf16cba7a18df4f61bb0d3b3c69f21c9b37f37bf88615867fb43074996f80e80d5df8038468eda0f9d9e658e3b865696feb6476611c0bb81e5e0f5b0c47de944e524fe6e8cf927cbf59b3b8dcb85a40381afc3853610f95b60401e17070d545b4cad57ff4faa9dbc911a6aa20ef17ce24d455ad99ed8cb8dfca22af055ca0e412d9b88b35a1e6759f746e7e00552856a827a4b18e499a22f50246c32ec99253263dc197417bb88abeb3dbabafbba5a2ea5f90fdbd8d17cbf9c36cb5583edece7f9dc6abf1cdd3fc65da3355a12e4940a80a0ecf7699fcbc5a8ce3f8b7c7e5e49cf4d699bcbf0d004fd211df51bda4d77fa3c7406a278fc9c945691259f4fe2fe3d233a277aa059cc55b5e7f798c9f5677d7f1d7bd4a53bcaaec1eed57eda649b4d77ce611d2245a9b439a06411445c1219f63729592d489f16af4bf65357d58e349ec6ee93ebca524ef05d832d14a9e92ba952ac7d59d2400d61936d268014f378b63ed70770f7f195d46dc891f4f9a241b77f6abb1dbc1df010000ffff267e6dbbd0060000",
-		"d56175353bc8de00d12c9ac2f18cdb17": "1f8b08000000000000ffd4575f6fdbb6177dd7a7b8688d5f7f032a1bc31e5608c880d4d2522f499dd94e816228549aba96b950944652890d57df7d202947b26c67deba00ab9f4ce9fe3f87d4e13d918ccc39aac00308c7c3cb68124fa29b7100cd6fb3e98739bd4339ccc582a5fd09a64c69b9be95bcaa3c809bc9f89768388bdfde8eaec268121c711b652445eb10463757e38f8d65e370c1f415990f47b54b8805cfd728add7e59b691c461fe2f7e7d7d1f4e67c180547bc2edf4c43bc7f4f325405a1f8e87c3319871def83ce37324ff6bda34b977e78753b9db9da8fa51ef252e956d5c6d5266f7c8f266efb4e671771389a44c3d978f2b119d65d39472950a3f2954e1d00e101c3965d21f3c4f3361b600bc03fa0ffb6643c99e5398717a924094763f13b52fda2aabc392e7289b1a29215daf0c2075c15b9d47031390fafa2f8761a4de277e3ebe8ec73f1907c1ef45d0ccfa3842ed17814442f2da58c73fd7af0204951a0ec3cb53ecadb6c502455e5794a93d4d1d18742624124daff7353b2fd479748ef3c33c3ba9b48180a4f73412468596255f9a0cc6a1bd4c622d6c987c492caf35e829d02d49d7b3681c9cb0c5103e87578ed01d8da82c752ea099d38d6baed817bf3009423112e16f82bd0a834f8be5dfb7628e0fb05918473e44d23446ab62054d7d3758386dfacdb80b3b97aed420e241ac81e979413a5507df200344995295a129122f4984870f51a7ac83143a12138832e376724558f1d6c365bd3aa6aeac21545378bd3c346d6e7e9c8de4b8856484b8d40f30441e935474701f81fcc5069e5d9957d11c0c960fc25ca5b9a3d927a87d6c7887d80dad633e78cae03284ace1be6ec73c2f6758c055df8bf2920cd7e3d1d52b31b4eda8bcd617022eeff29388fecebaf00f6b9603db23b9f38835b30bbc70e2afbffd7728e3034eb16c80995fd0567abbe46ba1cd025911ae580964a0f542e0491bea244088b4c8dbe3de53ba03e058db5df86013fb4eb7eca3427f33ecdb38ce9582dc9596f331cc5c3f1f5f568164fdf9d575d53898b58900c770c27d1cff1f4eaf662cfbae65fcc1267bf25f3286c4c6b9b0f2815cbc5e1b8df12330072c1d7dbb99bb20dc939cf1fe20561bc9418589e78ad536128916804a71a1d2b604e1426900b90c8912834a13cf32d0f1a16d49f764702d898e43bba1660747d7e1159ede7d451af2575079b4ddfc8bdaa0a7acdd067e7177bec495c61f5275b43af090bfd5d9ba2544b97a9b1d9b59019830316cf00f01e10cda82fdf28f89871a03654298966b98005e3a860914be0c4ca12a5cd2cb71078b52cf39d50f4ebc7ad9ddc95fe6f895a36f27f8bdaa3b87b06e0f6346c4bc57e8826d3d1f8fd59efff4897391cdec25f80961afce495ff0afc057ceffff0dd1e1fb2bb8449fb6d6ac9efdd773b12be7e45896e69f381d1e603b3f61d0afd75c6e1e0ef8ba35e5cb002391338c8f2be5ac24fdd12064ee21a1e986087d32a9d9e96f589b43bdd75a31d17ab9d725f77027db2c6b82a98c49889007e8484acd5f36f8e8c98abd7ce4e7193f4efddb1dc5624db4b6c43e8fa62d16589190bd51c7c331b8d2b0dbd03f749f07db1bd739ef5f6eeba00a428f8da50718f537f7f2af69a6a8a9d6a92329146e2fe1f9c22f56c32224ac2ffedd1b4efcbaddef7f6da57346faedba774feb04411806bf3d020fe0c0000ffffcd50994144110000",
-		"d5c79e86ea8df54a6153e4b698b330e6": "1f8b08000000000000ff4c8e410ac2400c45f7738a30abba70ba177a04a557886da8536692924905297377d116319bc0fbfcc75711eb55661a2c3066820efcb6851b66aad53bb7a4758a7c45c68932b1c1e60000941629d1442395037d6e521c13f5df4e2f6a989ad32f9c076223fd23199fc4d0ac9a3aff305bcaa56dc714ee914df11506c9ed6e3cde795f53fcaea8aeba77000000ffff3d675629c0000000",
-		"ed19bc9d1eecac29400a2b85bce057e6": "1f8b08000000000000ff4cd0c16a23310c06e0bb9e22b037432c58f605169243ce7980a0d1288e82c6362365b7bdf4d98b674ae9459f8df06f24e456ef5ad05af9f3fc7db4c664f97d31085a8b04f8cab89056fc2f13f58ea69323a46cadec3527481f90cb4ab309c2f4529b8f4cfc1004f875b8d410337d1e2ea7f35fc83a0b41cabad8689ed9b4bb8c1e643672ef140fc87d6d4fe180ec12a1b5f816752577c84eee7b3e006ebf21e0a415a1bd0201bee6a1de4d99425bfd31d448115af901b794ef73ecbc0deabf796719b88e3a371ef4e683d065a76fcfea76e3bbec38b894456a784edfc75b027bb1d4b18b7cbadeaed15681cf000000ffff6e5c25e374010000",
-		"f08bfb9f66faa388105b1cebb7e8b7ad": "1f8b08000000000000ff74534173da3c10bd7fbf82334304dfa133bdf8d05292264d3b092167cf5a5ecc525972562b8ac7c37fef48065220dc76df7bfbd66f47f6ce02ab95f3a2029bacebd44b449e438153679754f5fd77e765b7fbaf57af6103cabbc01ae3c0036ce0257553573720549021698f72e32ab2579d1f237bd436ecd6a8e507b671e017d4784e45ec82c3ad36c193b33e1b0ec743d5d86ad417e5723448d5daef8b95d4665f36ec1a6421dc73dfc4a5707d373be9e627dd1363491a048709dc7f467f9399d5ae245b65af8bdb9bcf2794cf3ceb710d64f7b0a0971e8cd5bff72dc80213fa98f56b20533e81ac8e81cbd6424dfa8b05d37af21963f038c7c6b1f8834bb0248a131667cf9dd2c61b461f8cf87e7dd7d172806f0335b350187c00edb41b0887f74b6bb741860ad53a916a5b9bf9f515bd28992bdca21e0dd4b888fc810163ee17d38367941c6f90ec49deddaf987f60d17568cbddee2cce1dc92314d3fbd34015898142bd05e436af619b330ab7d9a7c9e423c11f20c9fe9f4c2e59886f3eaf40305f0299bc76255e7df4cfbdfc0e046f81cc4f575e7c50f0c8b9b8df78fdd779f5c88ba8885153e2bf010000ffff2156a586ce030000",
-		"fb803e371806c197257fb5ae51d77872": "1f8b08000000000000ffc45aeb6fdb3812ffaebf8227e4839d8d283bdded43bb29d026e96dbb8f1649ba38605104b43496e950a44a524edc20fffb81a4de911fddf670f9e048246738331ccefc666c9ae5426a24648a97a0679250aef08dd08c729c4a9230c0392b52caf16f76f0521432864bd0de1e949aa81b55129e8a2ca70cda642a9794a7734932b815f206cf84d05d525970fc5a087d5170cf9b1594252a9634d7e8de43082109b950540b49419543e66f1903d72047e37a24232be0237fa175aea230741aa96a2f21d3303b0efd66fdfd3d9d23f88cf039273306bf91f90d415a16f0f030c4d3b024f10d4941e158f0392b806b4c4568d77419034f4a26ee33811c78023ceeeab08f0431234ae5442f467e2c329c52bd286698667326d2e8c650042a5e4046020929555aae03a771e00c104df0733cd9205d770377ae23bf4b3fc54ff0d3a93f1e97fa3c785e69da52139a8cfc4d27ed8fd10aa4a28223ff181fe329be38fffdfcd5e5b95f93525152e2da4aeb20239ca49001ef709862a34c8743cf84978213599ab096cb8c7d2e66d015e699dfb68459bc24b18845692a33c04163a6538d49de9163829f37f29b5349e87c6e8c52399bca8566a0549be8093e7e8aa77ec7648fee54790d9739e9ea6d8f602f522e884c3711ef72b84a9f8e97e13dbcac671d3ce9da271659063206c3b41b70c84a8a2ef1f45945dd3e9fca3797ab6c9372d5929bde690dad2977778e37b4f8c1f3489eb3f5c8ad442768b7a33ea2881710df28bd663030e944f1c79e974a51e476bdc8f09cd1bb59a170bc205283f4bd255911ac6c4436e195683aa38cea353a41efc88afce524c77f9d5f5cbe7dffe7f5f47a3af1bc156188c3ad0446e37285d9e0473c9de289ffb39d57b7244d41b6a68ff10b7c5cce666bf5999d0ace21d6a2bde8399ee0e9d4b78bc897424287c1143f7553b914c90729e694013a316f4b88355e10f5418a1ca45e8f7cb3c4e80f775a92bf7d67dc53268aa464e97f324c7f15775a707c717aec7bde360f36dbd691b925d54ff88971a9ca9dbc818c6263f829702d092b538a1d42f7a8900c9da042d226b51806952f98044019282db809300fde57a78d07cf5355c2ade48925100d239f720da9249a0a7e05cab875933d6ea95e9c0abe026ea647bdd41d4536b0b7099a0b80958ccfa81cf94ac6616f8fb076cc369d0427a3da4a5aafea51c70e179c56a906fd70821a9dfff63342b9ff098b42e785463fd853a469e138abbf7d0dcac0034db38687ffa92b606fda6cd1e2d7dfbfb3bd61ef7fc27d1635ff8726f519c8f28bd1f5e5e6c349c00198d2fd2e0aae905e006aad47664be562527dfd5720e99cc676819b32abac38a0cea854a86bb5fefe950171dc90789d14bf93c1a009b2c25affd5dce02d8bd92a93b9532e14bcfbc8a9fec0889e0b998dac4f0f601e9ae5ccc64abbe566d410b9c1c03c074abb4038fe6616c12dccbe071b12eb8268f15d44d2228b89dec0c9e603a234c8bb8ce125896f94e0381349c1202a5f03f71a746eed378994104d028b4036f2ea838fc8fd0b24cc19c49bd4d946a974c2e82c582637cf4b6ae3616fbfc9b4f64e36f7c8c50067d5c5b1d1724614448be3688a7fc4d3172f4c2e6a45abe1ed62939faafdec4bbda1c881cf81a6d529fc533e1cb4410181a4b399e083522d494225c18532993487a87ac0b190103dc3133cc1a717d34ebe80bb9815098cea7863182de80c24271afc23e43cc9ccd4a381492e7aed504ee5f00f3d818c412bb081496af29b012d513516909c46073d3832ac964848643e824c70584706f04ffbd6b4f3264a45cdd3319eba95dda516c444f633882b2c1358e10e0601ce230e35e89b8bbba87e0a4aec741c1d7451545fd6ede4414107187439d81a84f2943082bfd0fcc765e43e4d35f56cd0882427f1022ce6165c45e5ff2056ab686a491e1d1ec9c817c1c9ad8ac8adb2e6095472134d0d5a7cfabc36ebaeea210c911d7b64821af79405ab01fd81024909a35f4046077dd43660c63e8f7e3912336a26ff112b2d8164aa162b81af6493096ee1244f039352650cb91652ede6321418ac4c5560702fc7f8097e52d7bd5bcedc6810d98f297e51df9c1af8eedc7b9f58ba958182b89054af2b26d57bc5e0b1ef6534964289b9c6b69888eca749b174054142a5bd9deb6040a8e8a05d7ef85dd0b1fea32ecc1af021a46eb75f2c027f2db2bd03741bd24407f7795dc90c942ee3077fdc858dd5d606b75fad737030b225cf008e6a85db9aacd36a6bd33bf1d47b8b1cf2f59f707b61026e65f606febfb7d854f54a83b90428b9ca573235689351a5dfcf477ef09fa5924f263f9d282d69acdb1ccddf72955d11998236b9635a3547dac8b953d474843b42a7225fb7aa15af91c71c4d9536fc7e2543b94b67fee16178889744f66a8eeefc3a63adf9876617cab518f907b6ef784665c8e8ac5dbd945cceb35caf4b083e274c415baf14f4ebf59f24835fca36e6cb913f734f1da96dc56c1dbb298c2fd74a438653d01f7ade8473b7486147d3124a59a29d0447dddd5a87b687a7ac8844bc1c77f13ea974ffb9b1ce1c8d8634a8d100384a7f8cfe758278c158ff141f6fb11f3facc56b2118103e1af7bcad12acc7babff37295f5bddc643e8b5f6ae812d63b771dac551186213aadfb3c5ed5f92b3773f7ad7c692201ae2f7343fa7234c655dd0be7245e9454ae6073f5b6a993bbf57905eafcc3c30a75577e3f4c20a11f064d6d4155ef8cebd985ee4d9b84df9db5e2ab05d8fbdf340a34dc696c2ef19b32d81bddc2a627d67ac477f5f534460a43043c4162deb6ac6b38317665b1fc0a2449e18dbb44adebeb7ab8616f1d863b88fd8ac3dbab7de89b5525f59656b391b86e337bf55369e4324f747bff55f240be5d8e1742695c48e61f21fffe1e5beebf0aa51f1efc8d34add6a0219be2fb7bfc8eacc8e5e36ee136364ca494b7f7fddd0c6ca348a9666466cb8f6b2d6ea043fd5181bc3283db38940dc1df605d929af8b9078159b62785bd1a26172b4360b340ced323f790cc8f904b1caa7c30ae5c3e3607e606ceb4b0b6766fe79db78bcedb0709098d8986433bb85938776ae73c1609e5a911f0e3d59be0f98eb39e514e2405ab90c51fb6a95a39ef364f2938d5d8ddfd0f442f2c071c5acad060b340822a9856f6e56870a6df2dda22aab943bddd066eee2e06545fd42c1c87eecddd48ef9a6b47c88640abd040b372d7d9d40c6c7372e3e264cd4946e3579cb0b5a2964842a1c089ae76dea1cf05c8f57546eeae2568696fc34f93c99e64b7846a7bf32793bd68880905d729d1703d27945d6722b1b7e996c852c56ef86d625a5543d8fee73b7b3e7f804ce165f5b5997debb74135e5d6de659c35286434e008552a83b830abcf8826adef0c0cd5950418652437693aa1d2475a201f1bd1cb74e7460e0f4bc72dc3b8397b17bdc7e3ba7bbb51fab79bfbb91b14e9fae3775043d9a10a90f4d5e97970a5d91eaad5bafc3fa4435dc4d9f79723b4f51006409353d3ddb0aa00dad245eff5e3ff0d1c24d1801c1b14974758432332d720912c38a73c759d7aec7b8fcd8715e837a648d968bcb67d869cf5b031d2167066bf39ec573f9b1019b28d98378231716bc45f115600a20a11ebb59d752dc7ae9cda3fb8afd349588a531df05dc6cac7165e431ddc1dabd52e2cf9ddb4112c01f92dfa18710630bdfd677de0dc6c673ca591d696a967655b8282eaf8801a3d9a76055946f27eb1dd7599ce5cf557fb11d547c30b4af4dfbe96830b6b82c3c330d1223c3cf48739b617c6826b29180369d60f2eef56dce3962ddb3f17094364fb583bbebc95c8f5f42eca96de47fbc56bfda56bafe18767c5972f41022bfb8db950980adf33655feb8be72ae87d35df36cf07cfabcbb25f1eff38c291e39afcb2b3d7f99d066e306815a6dcb7c98f04fada5d1271cb9920093e2b1f2e8b9989e38ff60b43e56646fefc4e07e5af0a82b8503a980971e37a962b4163e000092481bd626dec13badf687cad8871bbfcc09d62e47f2d6cf835d498ac546cd56b704fe5ae16f3fc370000ffff2d3fa7d93e270000",
+		"0b2a938e7e960efa8ea8c8f28bef0e4f": "1f8b08000000000000ffec586d6fe33612feae5f31d8352e778750c65d3f7421202d125bcdba79b16b3b0116c5424b4b639b0d45a924edc4d0eabf1724e558f2dbbeb5e8025b7fb128cd70867c9e19ceb028129c3281f022a6f11c494ef55cbd284b0f00c00e82a2003605fc1dfc8b05e3c938cb38bc98499a7024b9cc7ec358af150008f8ee53fb51d23c47b9f3deda5145815c615d2dfd7f5b629e29a633b92a0a144959da3f2065e92da96474c251051e40b7dfb90a87d1301cf403d8fc8ac2ef66f103ca4e26a66ce60f71c69496ab3bc9ada1c1b0ff73d819471777bdeb6e380c0ea8f5523a739e75c3c175ffcd46b2fa15857fc9f4359d747a954a17739ead505aadab57a3a81bde47b7e737e16870de0983035a57af465d5cded214554e637c561e0cfbdd2dedbdca039925bbdae19533dfb9be1b8d9def874c77f842e99ad746d51adfe81e345cd71d8d2fa36e6f1876c6fde19bcd663d2c2628056a5444e99903a0bb47b02697cb2cf1bc8f64dc04a799c448c592e5daf082003ee599d470393cef5e87d1dd281c46affb37e1d9bbfc3179d7ae28e879968346c331dc3bccdc6dde7acfc43d6cfde6fc3ebc8dfa83f1e88c7453ba44e11b66fb3c8b295fbbd2a0fb418f9a525554789ed274e64281402e31a712ad031313a0f6299e63fce019fcaa9d0c85099f5126a8042d175896049419ad27355a39b54a04124b68cf7b091601a876ddb3068c5d66822480d6564c7900d6b7e0d9956a7f3e218910f0db0e8947883952e1e602f2041a950642ec98d82d0342722a29e7c83d002a359bd258571be8d0855fad7c9bb3893a7573d94d95fa791873aa14aab78dac64fc4897e211c845e587d91e3a43205df5c0f2312aad0e1b3dd154ce50b7ffebff48e5c92954c39a2997e1d814b6436c4c67ca3aa1e9cce65f49c50ca1c544824fa7d0428e290a0dc1d96155036751ac45cbb29e510f980d9f62ccb55347fbfc69b6ebfac7cd1b62854f182f34429c25084aaf383ad2c2bfc0edac1dd90f1f45b835e32d2d82a2d098e69ceaad930dfccabd3ce32c5e05902f38ff629a9a2c6919db314e8ca97a50657990a80759b644c9a6ab3abd8ec1f50f4b0c4b4c4af8eaf9e15cfe643e98b51d83e21b60c091f3abc60e77acd95df6ecf32f8b09820dc61a37766b9967d9514c8540b929fd2ad2d813f299344788512dc5ca13e56633816cc6fe8c694e277e9ca529d3919ad3b356d1e9459dfecd4d6f1c8d5e9f97dba212a791a029360487e14fd1e8faee7247ba625ec41227bf8e815e77235ac9dca3542c13fbe7fd4649069009be5ad75c6699e654e73c7b8ca694f185c4c0526e537fbd848e44933b5cbfe1080613aa30814c80448e54a1094bcfd40cc1865055856553400085f1aed1da00f46ece2f435bfebb02b955eb76da45e19b8abf2c83d606bff1f9e50e1113e758553969686da605bf29932fd4dcbca8cb342564ca605be26fe2ca3eb036705cbd52f026e510db3917926a960998328e0aa69904530f286d4ad409c7354c5e55411357d193ea753371343ac40baae63ba9625387ff05e0ee341bb576e33e1c8e7afddbb3d6bf319e67b03f63bc8778a1812427e404c814fe47befbcf0e67d28784496835bbb4e6b746a7577d8aa9aeb57a6dd3c2b5cd983814fc55cad79d5ef3f7de55df51ce72e44c603bcd7c35871fb65d68bb6ec430c74cb6dfacd2b38fb37ac46c6375dbb31daef4b7dc3ddd9ae8ad75189f722631622280ef21a12bf57504504acd0d403530463dcfed3659ba93a21606adf57dc886f4559f088062c964268c734603c09c5e81156362b677ad23f76d7d3b03b0907cef215d93db2ca2495d8355ac3910433a8d4f1a5a7bee428010b1be2f396beddcd300d03ce72b131f4d04f7ba6faf50cceaab758462f905e81d9bed33326285614ac582f22f83d0c4f322368974ef360c64967c10c267a1cfc0af7e215503a81973c71132e6ff2478b6a7fa20368f7314013820f641f5c700af4684dc81150000",
+		"0de43e66867f973b678d25d7984a3276": "1f8b08000000000000ff5c8cb1ca02311084fb7d8a21d5ff37010b3bcf46b4b412fb98dbf3827bd9238978227977514e0b619a998ff9bcb61c5df278100014553972ca41231a98855d9af7ee3576e1bc0bc268d005e13ff379da6910f34f95a8b87cc9f6164a7fb88fbcda68cb7b97fc7a76271e35953cb757a6416cc8dbe84ec22d1a9474e52feccb0fed9c642600a854e93900734f5f25b9000000",
+		"12a7caf441d54ab5af40b373c494e963": "1f8b08000000000000ff8490414e03310c45d7f129bc6c373e0374c706907a824cc61d59cdc491e36911d1dc1dc12051091576d67ffedff2af319de3c4d83bbd6ee3739c795d0164ae6a8e6a13b56a52a693c599af6a671a549d8645f2c846c72ff8586b96145db41c36f06fc095076a6c97cc4e6da99fd7bec30eaa7edcc853119798e59d0d20e5d81afe26c86fce656cf8971d3b407878b9b0998c0ca19a3a27e711ef7d8049cb49a6c578777725fe487bec10c28d404d174bdc76bdd38d752b780f610558e163002f73b52f81010000",
+		"1551d8c7e7c29840c465293906ecf514": "1f8b08000000000000ff4a492d49cd2e51a8e652505050482acdcc49092dc8cf73494d4b2ccd2971cecf4bcb4c57b05528292a4d05ab488689a465e6a4166b2841b4eb55e6e62869821514a516e4179514430d04a18adc1cbdd4bcc4a49cd41464934030a304bb5c2d572d176000055bcc0499000000",
+		"1657259822040c71806f2179d55703c6": "1f8b08000000000000ff7453cd72da3010befb29762697840966a6c7dc08b46948a6a5a5cda5d3c35a2c468dd1baab151d2af4ee1dcb40fa874ffbedf723797774cbac5e055b0f83418ce53bdc504a83015cc658de098736a5ab6ba8c991a0d212aa1d7c91c668f3f572addafa9bd1a8b6ba0e556978331236cfa40d0667d624a6e1b01c65f55559c43804bb82724ade886dd5b24ba92862fcabd3e9c82d3beee2e202e6c2dfc86851ec6141aad6d5b087276c02c1bed8c3b0ffe050e5de23ba3a604db08718cb234a2993b7c1364b50e6a6a733fec4dc1cf8399a67acfb63622c4ff0402f5ae9b86e6ebdbf6f74f889c4e79fcac2196eb15774d58283189af0a645b5956dacee8e81245b924356aee7c2caa6bbd0cd6874eabe65af29ddbca8583447e429bd21d420e48b18bb19bf765835349b8f532a86309b8fe187d57577c4acc5292a56e8e98f591fb7d33b1fedf7600f9a219c106c6c2dd8edc99fb52ec804c9bf570c8fd33af67ebfdcfb71d0f5ab94fa9bf528c697c4ff658f7f06a1b151bba5a91532cad29f9309e819385167731e70f58cd998abb3ba191a369c85339cf084c1f096046b3a6b59b043c98e5c7d0815013a6c76de7a60d7ade0444cd8ad6cdde37ebd6763efac3e6235b9cfc9319693fbb96da9b18e5282f650f6e39d766f500ed91fa9b65e65f7599a94aec16eb0260f28046df06bea1e4277a5f39e7f5732848750913852f2b0416757e4d5c38a05bce6a702e896d00a2f8351cbaef835008769cd4865040000",
+		"1bca5c6fd6605d933bf0c7d967977762": "1f8b08000000000000ffcc554d6fe3460cbdeb5710da6be5c4dd6211cc2db5bdae912fc3ca6e0f45615023ae3d9b19cd9443ab6b18f9ef859cc8915cb7700f0516d241e0239f1e391cf2dd3b1831a15009c51616568b4d3098cfc4d1f84a0186102fea61f264aa52c19882f55b4795248e044b14540940858e14ec76837b74f4fcfc6a8901f58b796ae4168bd16ce4ab2f6635b8b9cac754dfb72efb008b05d9d89041f3cf3e1b40c158e9b50287518893184837be4cc11a8d51c1fb042092252d9e1b04c0a1e8f56d87f604711446a1d5f62542b681142cbcb5a65a7d0a250aededdcb5b45c0ebf7daab04663b1b0a4e0f2cd9e6f78450a860980900bf610d5ad19403fe97f48bccdb479b4af044d45dc09c94e14bf7d8cc346c76e97ee7630bbbb9e4e96f7d77713787e4e7b7eda3b87cdf1fe967ec51ad3df3b18f22a3640f61539fd01520c61d07c767d8267e9486adeec4decdcb334220663af9f885f7b60f22df8486503f6b43045bf614d477c4c7f6c281eff05c091f3bc55900e073f4e4d7a84eab00105e9e5e0431fb1c6997fe1faa9cfc484a5a928c639fbe270fe2fef5a244c498ea902ca5ac1056ad9a078be58135a591f3bfda7c20098ca88413b268bdb9cb4afcaa860787999f448898d2f0ff0fb0e684d4ddf6b1a1fce4f83aabaafb3bd02f97c31bb9f2ee78b878fb3db49bebc1e3dce3e4f7aae0035da0d29484d25e94996f1cfcbf9759efffab0189f0afdc8de1d97a9993c9a496e68bba02f7f47db01a9d7c8429ce94d14ef88b3b2c802c6f8a7e7f244d0136d159cc43b5a7f79c81f973757f9f95af5fe02de6138576e59642f31273cf71aa12cb2b58f9245592549926559f2ba2f72e2da68ea6d947af87fee0edaf79dda77f45e2f6a4d312a089bc21a7dd81c9d99d556f3100210d88bd7de2a781ccd5bdbbecfafda3e15e415c979c3adbf988ea67c926559f2d7005b60214887070000",
+		"1e0b5f439747defa96cd31fd38a37345": "1f8b08000000000000ff8c92cf8eda3010c6ef790acbeaa148c5b9f64ea92a4aabdd857d00c7198213c713ec315a14e5dd578e2384b42270723cf97ddf78fe78b4d29d4201accf1863ac73d881230d7e0adc042fdff9888b237a12c119fe83f1be17bb187c0d05acd01e7495ee7fd0d330f045dfeb038313135b69ab202b60bc4132daf261b89b2011a204828684830e1dbd483afa98f15b11b4297f6997a71f3e4fdc74888fd6f0c523eb262678c23a71d3f14f6abbc3e014ec805647504d4ad6f7603cccd453cbb3147e544e3ddbc8b34c562b6c3b49bad046d325750c6c396366b0d276aef5db0844a7bb169dc31a14fd85cbe4f35fb6f08c2272cf4a52bd6babb0d4b68aaaf7fdefe5cf19451dacfe3213918f43c9093c2d1df860c88f979bdd5a5b5918d848850a19b930370b856770b202518f789ce0dba32d48e89875fade83a724bbaec0ecd0a2742cc73b35bdfe2e9b1a77a55ba92d5f648c31366443f63900e93414eeb2030000",
+		"25dbb07c80e3c1f6ba1a068d864e18be": "1f8b08000000000000ff6ccec10a824010c6f1bbefe2ee3dd843d16287a250ec14c89a830ec8ee32331528be7b54040ade3ef87d877f832c84f54330f89d633059bedd1f6d551636af0ee7934de68f8b93cebcc8c508a43fc00b2ea9379d48e4db466b067ae21d58b5e49a1e54a056cfcfac7f908ea3cabeeb0ac418fc34a5357a35604c068c850482f5b2bfae54bd070066d1a61ad7000000",
+		"2cfa36febe503e9f5da69eb98006af86": "1f8b08000000000000ff9492d16fd33010c69febbfc2da8b578958dd23957818a30226c4aa1578bf2557ebb6d80ef625a5b2fcbfa338a12a8c21f166dd7ddfdd2fdfa56b7b432eca2416d448e583d1b10be4cc3e80c5830f4ffac17b5672c010c93ba952d2bba278eb3d7f9baa39abc94f7eb6eb063b740dbafa58597060d0a23b1f73a557fab5bedf7cda5cef36b3fb11065029d15ee277a9b7503f812167e4c501c245ce93e80041a584aec95964214cf07d27df14aef7e37b64f9b5a594692ff58933a5f337b611735ee995beaa769fafb7bb0f775fe6d94a44df871a6fbced80e9815ae2e3bce71606d83def8e9b45c0ce47621f084baa16067437e838407bb91c894fc94c8a942a19c01994fadd594793edda121a7021178b9474ce455f0853fa9b2df48ec9e29d6b8f27cffc49ff4ab60b7ea0069bfbc9fef29fb09e8ad5f8ae2243600c157b5b039fceb2608cfcf137fecbff1c8891d572cc6f813feab66f509643af27b0c7de11eb811c8341f54a5adff42daea52a8d6a6e54e80c395462f14256cf31ff8c6cbcd7a81a49fa88b75f1df1b605defb602f97228b9f0300082b34a740030000",
+		"38078b56844b47d94c610bf7ad5d6918": "1f8b08000000000000ff4cd0c16a23310c06e0bb9e22b037432c58f605169243ce7980a0d1681c058d6d46ca6e7be9b317674ae9459f8de037bf915b5db4a0b5f2e7f1fb688dc9f2fb6a10b41509f08d7125adf85f26ea1d4d274748d95ad9674e903e20978d661384e9a9361f99f82e08f0eb70a92166fa385c4ee7bf907516829475b5b13cb36977193bc86ce4de29ee90fbd61ec201d925426bf157d495dc213bb9eff900f87a0d0127ad08ed1908f0d5877a37650a6df547a99122b4f11d6e292f73ecbc0deabf79671db88e39371ef4e683d075a70f96fabaf1223b0e2e65951a9ed3f7f196c09e2c75fc453e5d6fd7689bc0e7006e5c25e374010000",
+		"3d16d2ae818e792a5e0caf6e08ec598c": "1f8b08000000000000ff84ce410ac2301005d0b5394596ad8b1c404ab11e40849e600c69086d66c2648248e9dda58a50a1e06e183eefff047604eff43c9bdbe7bc4274cba25488895834b1373971403f3044f7201ecd9d480c14214b38045fd899fe1db9104997d2142c4820fc6b70c16d5c9df7193b41ceebc4cdf33b7328a82304ac807d3ee98e199e4d2f2bd3d67a5687df8e664f69ab23b0cfb55ad46b0028d229ef10010000",
+		"4776a8d6fc6d9ed84770f4dbb123faa2": "1f8b08000000000000ff848e316ac43010456bcd29543a107486240708019721c54491c5604b2346e36c2174f7c5eb2d0c6bd8eef3e13d5e413f630cb635f7b5cf4f4ca177004a85452d4b74b508e53809a6706199dd2fb3baf176be97b2904725ce4f095c953de789e22ae1ce7f30ebd1016fe7bf5fb0d6adf270eea5b60198aaa8e4ed3fd39f4d48791875b37cff5894585f6c03631e829dac793853beee14980ed0e13a0017c51cb823010000",
+		"48d8169becc3d8e72361bc784cfe43cd": "1f8b08000000000000ff8c51cd6edb300cbee729381f87c94ab75b60a7188addd25cdac3aeaaccc402245110e9c679fbc1aa1d183b34157422bf1ff263f338060fef98d9516cab877a5b3dee37cd37a5e0893a3c9a6c210f1e8151f8073022f42289775a5bea309a6cebb3937e78ab1de98572eba989ab5cec70ac7b091e94da6f9aa9c82830061fb9ad26c1b51ee5b39e217a1a6803cb2b84ddc8ee46ba5c2ef5e557a1fcdc6e1ff4dfe7c38bed3118e5228b8916d774763b2edd03592365e33be6f0495f7d68d52377ff99443a9a809c8cc597affbadf5f64570494a653c4132d2b7d55c61fd66d8d97a0c7ec64ebfdccd52141ce540a663a013488f70c688d908766052f2ee6318106401c78021c9b59ce62634d9284bf1e4ce104dc0b6fa33819e517a5aa65b5e933225cc729d911d1d497ea7e4afaff4e40d7309a38277e3076cabefafc8c2955eb9e995ddbcf92d948ca7fb61e068314d3b714944df67b89028cb97e1431c18bb05dde83c786494fde6df00e473db2941030000",
+		"4fe9ba136da998b7adb875763035b2ae": "1f8b08000000000000ff2c8fc14a03410c86effb1401c593758482c84091da15f4e24ad7d2a34c67d276a49b8c93ac52cabebb4cbbb7ef0fe44bfe485bb615804be910bdd3c8542200d26fcc4c1d925a4899c379ba7182ab7cb0b0574d628d519e6c7012701b09435549ca9176c5109c3ae13e7b2c09e00a8edc03210650064185faf9ebb5693f6f0b7c34cb11e66dbb6e96354402dd23644c2c51391f61f1661675d9d4483b394bfbf2ca77d878db1de5e7608db93e8ddac19eb188ed747aff3098d3e9eedd75380c4f2bc11545cf01679a7bbcf17b979d57cc2fe43944dacd7add3e5e2e086672dd582239913fcec1c2453e6fdb75b3ac87ea7f00f7c4463747010000",
+		"5ef075cb22365d9499df5d53e229e0a9": "1f8b08000000000000ff001f00e0ff726f6f7450726f6a6563742e6e616d65203d20277b7b2e4e616d657d7d270a0300a81071bc1f000000",
+		"6081bb9c5323d7786c745ba197d8de34": "1f8b08000000000000ff2cccb1aac2401046e17e9e62e076e16a6d9307b0b60c61d99859336499c8cc1f828aef2e12dbf3c1f16501b70c5f85a86b7aba4ed943be6d45399c486c4c4b49554db8e55a482dc4918a5aaec964fbc97ef8e3cbddd56e7c3685e6aa4f67b5510cc19b6262e421a86b8eaf19ff33e2ddd3ce29f0a8c22d230ff41900c49e8c8995000000",
+		"62d4f40c27cb7b714511ac408a8e3e32": "1f8b08000000000000ffac59fb6fdb46f2ffdd7fc544c1b794508972fc3d14855e452f0d7abdcb2541e21eee6018c18a1c495baf7679bb2bdb8ae3fffd30fb101f2265170828d82477e633efd907672f7e79fffaf23f1fdec0c66ec5e26c46ff4030b99ef750f6e805b27c71060030dba265906d983668e7bd9d5d8d7eec558724dbe2bca74566c5c8a0315cc91e644a5a9476de7b78483ff9978f8f91cd722b70e1386663ffe0078cddc77bba962adfc303ac94b4a315db72b19f8061d28c0c6abe9ac296e9359713389f42ce4d21d87e022b81f753d8205f6fec045e9d9fdf6ea6f078805c29bd8507b8e3b9dd4ce02f17e7c5fd14d42dea955077a3fd04d8ceaa29142ccfb95c4fe0d50f44b0543a473dd201b4b807a304cfe1659ee735748e223768e121e8365a2a6bd57602af2e8afb2aa5604b14f050eabd142abb294dfaa1b827b39ce9867fc109bcfaff36042e8b9dbdb2fb02e716efedf5f0f8bddc6d97a80f23060566b6f4c1abf3f3ff230bef47867f7136076b97aa26ef65a1f196e31d0544e0fd045e1d797dcbe528a09ed758575ca029455efcd8e1f6d37e3e04e5c7e2fea46b82bc9cdfc203643b6d949e40a1b8b4a82b3017c57dab9e39bf9d6c2827e001c8a9a31c33a599e54a4e602773d4824bac318674affa265a176dab246bdd9025cb6ed65aed643e81972b46570d7b8bc6b03592fb36dce2c8142cc309141a47779a15273d91a2d64a9317942027bc5c9e9f9f5f1c8c9e8d43c5cdc6bee06754728bb3992b139ecf7b7413cb7673116b7673115e918b89cca0b55cae4d6f311be7fc368cc672282b7a26708d325facb9156c391b87c7ca38e5e8c2aa1b943073c90d2eb97b14889e93e579478ea6e79c9ba96d21d0e2bca7562b52c1d54055aa7ba646f5042891740314cc6e9e002092d31ab8f07de6791dc817e9912e91b81bf2961bbee482dbfd61847eb350e615bc92b2b7a8d1d26fa60acaef45a1f92db3381b87e74e42574c9289a7298bdd52f0ac9d6e36f67a966fbbeca40a635ca2feac71cd8dd5fbba03b30d66374b755f73e13153b723732c84da7f7e4eea5549bb0151de72ade416a535cfd0b54ade009d8deb95345beeac5532a0f9078f157af428bc5b7cf0cfb3b17ff114fb175e1c587f5177522896c3175e3c973f9812215e6b64164149f895dbb76cd980299ccaa1bd91c9c5e26c36a68eb3383b74966051afd1705c87af779b42a3030cbd98060bed7a9ba399994cf322783053d258885d0be690ab6c47914ad768df08a4dbbfee7fcbfb49a44906d30a67ecc927180349e4136821c715db096ba6670e6a3c86cb0d02190cdcc072c7858595565bb01b8cc4b06592afd0d821e02dea7d541a9698a92d1a60d24ff6a056c0ad7149913afcd54e6654727ebc6fd03d0de106f743b865628743f0456906f070485fef1b977e55fb3217cc60623f71e3d1b8c37a24a586f83a4c86739204df430249850c2da007295ff215f45b34a15fa03da189ef205555e80a70e94ae9372cdb0478982f2262caf2bc2ff10edebb9140103d32841513e6e02098cfe7de65834145d023a030f8a73576f1682a4c3ea0d8a99517e444264ba504329934dd5211941217cc21896d259976923a12cc211853270cd6b42be227a867e91148bbb508b02774785a0825da3344fcac35dba7dcb8ff7d2770003f79c9e91f8acb7e324c063069d5e5f0f478d6c4cf9965066d1a8a0ae610eea69da4540cae244a1272756c0a296d00304fb9ccc42e47d3bfc1fda0e9ee0326376c295c20ad6eea1d2a91f655e4298f0bcbbd6b2b85468355cf95b6793e561428f3d71b2ef27e1057c9548d76a7a5df4af8b78f67f566436d2cef9b4273b91e424b55fbfeb246899a89135512e7bc6aa104ae944b89fa6f97ff7c4b06c645a597795854566c8c4dbc665cc0aaa0bf5ffe81990b9409060c0efdc3456fd108873725e69a67b9bac1fdf571697ba217f339c89d10f0dd77705c63cac94f68f0455be236b3a15421faea4fba335e71acddaf097c1f3b799b73dbbce7f53d384fa2b194ac8b52523512ae1f52be0fc15386e9e9ca3f5dd77aeec99846f8c133bb4acca7636d92a475a274d16daa5396d0e3a0bd2834b243350d4169bee692896a3c2947ea3d6e5e6de9cdd0873a6cf4f5e9d9b14aedc01ded3cc0be73a30736178c4117783d530fb63da1b1c34c4d21b8752d38ddb2a2cf2d6e294be87f6a35dff6078374c585455d1b73759424ad2ab5c9690f4a5c59f5efb8ddfcea96ae55a5c31ad11535cce1ef9fdebf4b0b3a05ebbb5b636980aff665078f8069681e83960ef4df1deafd27b76451fa6721fac9154d1023caaba4ac98a07e57cbb14caf5db5372799381ffd14bb5107c1354c0245a9235d1ef8888bb48379238d4fd0562353b9f7ca6b343b41ca3f780da2268f2521256d7b54e8f200a9df6dc0bc314a3fda684fbad7e6619f4254c920ac15be7e0d6aa4f47a7804493bfba721892a421e6354b7f4935865cfd1323245e8c13176b9bd7f5acb92b65bd7e3bdf3d3b8c73cc920f6a66309d56df4d3d855ea6eadabbbe8a731abd4a7343596adb95c7fde691193f52a62643cb9ae3c8c02ed68a745727d0c556895ef5c093e0b8dc83d540de9f144e7f3d5516b79ccec655636be4219dba74c1d427b9185bd6b9a0966cc3b3a36a3763b3d1aafeff6925fdd6c4a7d2e4dd30ab92f7b3a61446d8eea350908a3cb7d81c9041256148267eebc75fc875132a97b31f9f7e8231d438ec27785a412e75a77ed27f4e9e28aea675efb3c714db1f642cf5a3c1a5a2c251acc9fcca15a427675305ad239c2662b0b4eb94a3e7cfced5f3f5fbe195dbeffc79b77c9352dec89be2dce872e5a28692834ec8e710b2bb4d92684f5618b76a3f209241fde7fba4c8651ce24de0c814e7a27d098cadaa6c441b58393592fa2e854dd34edf1baf953e7a8d8819c82d9af8075a69ae34fda09eb39e728d31b2e735a9e4e80d6aafe5da0af838412a125789b67db655433bfac31e7fc5a9575664afd542e19a44a66826737143a5799fd417da2ef88b0abdb64cc0a3e0e904938a7e88a50333c5efd36d3e33e823e9c9c487a47505d7879be504da738034995d781d5f71c1557078613c1e8b7e6975b31a2392ca5e8a96b1d85920e92bb374d39bfad2a4c97636928452252aabc36d232d83ecced76398830045fbf42d2a754766f0dff8294ddb0dc5b34838a034a275637304e6c45eb58bf8fe1dcb13344e5f1ef37c9d12fbcf8d6f929b8bc39112e560d16d1a61b8d2b98c3ef1fdf065abf4bfdfde3db66f22c855af6ab3b3bc79fc753f079b95df0151716f96eb548d149c9dc06b70b7cffb9ee0f13ca378c80474c86ee90a8621a75f1ae107847175a919f0ea08d1a9bb6b0fc99d834e313c4a577b8a465510b61bd5a223d39bf4edcd1c2fda7881c9276ea6af990b886aae4af28b2ba0e6dfaae0bb2e1934bbcb7ef548efd641816c161b54125df2627dd19d4642b7c7f248f7e098cbb79dd5fca50e8734b1f1aa4b26036ea4e025b332e07c989f38c5ae6fa15869f80e2a63719a47683f2904ed4e11ac9d24251ba2de2c0bc31a9d2cf9f2576edaf8707e6341e33864a1b4ccf66e3f8bd67360e1fb5c71bbb158bb3ff0d004b9a9572fd220000",
+		"64edc3431c80e867812bd8fbafaef48b": "1f8b08000000000000ff0072008dff6b746c696e74207b0a202020207265706f7274657273207b0a20202020202020207265706f72746572286f72672e6a6c6c65697473636875682e677261646c652e6b746c696e742e7265706f727465722e5265706f72746572547970652e434845434b5354594c45290a202020207d0a7d0a03005092258572000000",
+		"697f4f0bcca6468461ca6a76969d7e31": "1f8b08000000000000ff7c8ecdaac2400c85f7f31487aeeeddd427e84a5db851117c80dace94683b2999592821ef2e2d2efc43082170cec797339da00e0082f0f038a7a1a1ee3c2a14aae58a9b8b9725c7405db99902b3626edabc337f0729e0953df88e5296db517a33d51fe142d5c7762e6debe1cdd770cc35452f4fda91252754e829e55df8fb787b7d1d39f976cf92cd8a7f0700e6ccdd070077291ba401010000",
+		"70a44a706c40329eb61d7e8acc4702b8": "1f8b08000000000000ffc457db6edb38107dd7570cdc60d306a58ceda3802c9ac46a1a7413074ed23e1485414b238b3545aa24e5c450f9ef0b52b225d74eef8b36056c7286733b7386745da7983181305095d0448a81b575cd3208ad7daf91672497da605ad78a8a39baede750d7a1d342915afba1ae916bb4b69a55c254845383dab442ff01c4daa0f393d024c781b501f87f042a8d3a029a1826851e7af1cbe5dfad18e09e993caa6b6019e027084f2bc6d35b29390ce68aa61c49a9e4474c4c67d2fd95d4e411848d4a6f7f81ab08da8307753da8eb81b590539dbf621cf5d3c3a3a3e1517bece8f019f83c7d7ebbc68b174385a5d4cc48b5fad2474197281e7551ca227c28f8dac163b5a2cab08c26467b54bea70433579e216733bd8edb5035c75d40042d3072485ed1c265174811050065a573f70930535424b983e63d14541b54f0c10b0c9dfbcdc3a343bf53569c4f157eaa501b77f45eaa45c6e5fd3465baa426c9a32040b174a2d1f8ec4d3c994ee2ebb1f73d92c902d59914199b87139c336dd4ea4ef1efcd3600389f9c8cfe8da77737f164fa7a7c196f40efe17679f236be9a8eaf6f6f2220238f4ce8900bb94c283fde06b2ad54107c9433ed827e023e0468dd0600bec84e04d0b2c66563b0285df7775482f09a95c899c070520981ea96cef59a5f1b91b78eeaa2a0f3759b255218ca042a67f731cd0da4ceda991429730c6a2db02c82aef9eabaafd0f59c57d5064b1d3d46c71c9385accccbe58b7e869ea503087f0028679eb87a45100e1b82dd43c2918aa69e401ec08d0e20c4af89f70184945451ce91f700ddb2552cc53d90d3d6564993059d2390915eb0f216b5d1fd647733ac4a2e694ad654db193d9b1540c3191f5d6fb71935fdea74b475150a7c0fc50f98540621912982362b8ee06b0b7f810f328066dd00211053dd77f5e7fbec8ff74a5d87e7feeb99abd32dd50b6ded4f77cb1215cb3664f7a5724d1c0b3ae3782305556054d518e8d06b043ed10040bbd516606b4943406da832fa1d33f9d3393379350b1566cfe15061a6876e8c0e0f9ffd2e747d643709156203dcd720dea3dec7d9350613151229082a2555e4abf1433db05d795f2ba21b8f8e9d6e1db6a8bf45a59914c707f5f9c5edebbbd3e9247ef5a4abd21a4480f61e59ffdd8caf4e26d3dbf19bf8aa3fee34260a8d0e7b62f020af137c02670a5d73361710303751614635a6200528e4483582a17377bfd164f1a740feb9826f6ba4f25efcdf236eedd9930c935cc260178ef6f6bf3eb9b979379e8cc0da017c86b48180cb391370d07b2100a9be62c55df8572797b1b7e22e09adefa54a89362913dbf17c6e97eeffc5e5c9793c75078ffb74f014986cde00d646df6c455847dede5e060e3ae310eeeab9a7955ff7f476b554c160476bfdfa3e40b1644a8a028581e8b8d7347127d06d8b8fb0e472e5fab9aefb07db179f1f795bfb975454949f94a5924bcadd439fb6df816940914995600ab315981cdd73c8a07f2580aa386a9099df1fecf53680de5e47c4d4c748f61ed9e2dc6eb4232c51a47a2cacdd6fa5a7b0be0cdce3a073fe5bf9bbe57a674e1f744835b07c65483faedb0fbde72eda0ff02f0ceb3e63f0a194ca6c13e7e01799532c52a68094b0a866a8041ad4fb7ba07726a1e6cb3c2fa960196a734d4d6e2d7c6ee6d6b46c631b1632d439fcf34d2f43a74092e667c8aae03daf8fcdb23777a7f1f46c7cf5eae2dc4fa0c64963a277dc6f1a0e8474e29e2610e7d5e0c34e6a67bc723fb9fc03c73d3b7549133cde13bd17580bb42cf90a48f6ed92d6358ad4dae0bf010036fff481fb0f0000",
+		"71877dcaf5dbdd618969be35f6442d47": "1f8b08000000000000ff8c534d731a310cbde75730393289a187cef4b2879692b469d2c9e799115eb118bcf6c692293b3bfeef1daf9d4f02ed8595f49e9e24249335e0c4d2120bef74d175e22e466efc1c27d62c5495fc1f963884a3ae538b013e0ec42598ca438583e3b565adcc71084929b9a244c6350b878d757c0dbca428fdcd2b5d462f845182689498f923b6b53e7a23b48ebfff259498f97305cadc59ef24de214f9628d751baeb50130e7666a89cb59bf67986e40a694b34e0e4abe2fb6a3f51473528f36a88ac3457069cc29dd6a50622a451a2f5c9a9c5a74e56b00141fd1c31f7023690a69ad8ba015673a515b721741d9a32845c55db4a99bdabbc8ce833b7717685927f611b137e438defa118dbc1702bb527650d15c3e168281a539d24a35c9c0c7a6b45d95872adb3d938dba0638519fbce56f47dc699a65b4613354348e8f4207a7b10bd76582a098cc30f49798cf4d74e8db4a53255f1707f76fae50d4405e5ade63023710a462b07fb35ed5972a694ad815ac9af06744b8a0a879ef0b63f2c7a52f1461dbcf458f1d42179cdd43b2fef716a60aef102a49576c0cebf6c4ada0d3aa850ac7a301ee7edbf1f5322f745b27d8fc429314a1ce58b7bd7c0b9e24b984f7ebe6da152ac612e1e3dba7656c376e6905d5b7c1e8f3f22fc01c5c5a7f178178578ecb30a18670b507a56db12f79ef94da29f03e319287d65cb9d863ca19bb15de3fec7f240e8ee232384ae435386f0770064f8660231050000",
+		"72454e64a95435640ecb95fca6ea09a4": "1f8b08000000000000ff94903f4fc7201086773e45c35e68ed629afe194c9ceaa426ae14082581437b287c7c2351d3e637f56eb9e579dedc3bccd9bbea4bef68038cb4650dad34c8a02c9891bebe3cd6f7749e081994886215a81f3601462fc190ea77b277807d463bd22dc6f79ef394124b1d0bbbe1774dd3f2b7a7e5596eda8bda02460152d3337d229dfdf8b43f514590bde3805cadb204bb600e2cda1e8b780952c4f2c105d1bfe76f2fb0c7bbee58cb322a3a1142067ed3d4f43d002ced191165010000",
+		"72d35672dbcffbb34a49978456cba19a": "1f8b08000000000000ff8492cd6ed5301085d7cd5344164bea6cd99722542a04b43cc0c499a68e1c4f3a63474496df1dd9b9e54fb9975dc6fe7c26e7cc0879e097d8639b9aab8569410e16a554afe5d6aa4ae96792a0233bf5b65529e98772f835f67843fec98e7bfd9124e4ac52b24f2dbeb4fa1efc1861c4568d4cb46e2ae703e5fd4e1b1ad0031bcdb810872f109e4baf37a98fd60def2de76ebf91ee15ed66b05eff989d3a2fdb5b0f6c51fed5320e4450babd7b555229a1133cfcc90956d042910d9e12b883151eeac10dcd0b04db5b67c356fda31f0e551c8dd65f4af0be00391ff959982634e1136e2781cf30e345b400ff65774fb7ded060fd58f0ef8f1faedf1da153f436fc319e9aa9ee6aa65d4009d78c125d905afc5e835b0fbdc33b3064a80d1c8f1336b422c3887aaa6019ebb7bf5b1dadc20ed786a7ef4794b03f2c12178651de540bc2a60a9c8fe7173683f5aab9ca4d6e7e0e002507cfe43d030000",
+		"7422accb3faca67aac698ef7437a3d3b": "1f8b08000000000000ffd456c16edb3810bdeb2b06690ff58114ba47015d20ad8d6c814d5a24d95e8d3135b6b9a2482e492b3114fdfb82922c47699cec3abed4300c891cbee1bcf786745de7b4949ae04ca058d359d3d435c825d03fc03f6fa4ca6f8d5170b672982b62d699bf4984b3a649a0ff30d058520617d7e7d33f67f3bf6e66d7f33fbe5dce8600800ad5863278ffe1ceb8c25b14e4b9371b27885b0ceb49ca3bf8ba26e5e919eccbf31fb3abf9b7efb7373fa3b269891569eec81aae8c40f5e98544e56f690cf43218b7ad6bd2792c98740eac699277efe08b230c94c3620bd74a0495a0953fc879697406818a6034cfa94aab8f0b0af83129a4ce33b8455f242505cc31609640bfefbae6575852d3b045a432f196449cdd6f2f4b1ed7d9919200f840f6c95c8710a3016489ab0efebbb4a4a4a64e2b725fe3ccc060cc23f56a2add0becf7b1a4abacae0395566118ec007c00f3c2491b3278f89f06e169a7ee1d0845a8bb4280dd43201f8075d4b0d67dc09845874a91fac90b3c2d2b7d07ec730f635114b82260535f487b4b3ef85ecf24618c2527124eac49146f12ae43f8a585ab6b7ed13e7e89b544ce7cd31c235d454e2e777d97d475bf9d99c685a21ba3d141709bb8f68412fa883b48180d563e91a8ea1afc48813bfc8302b765dd08d4fa2d2a0f22f6efd0a565be038e6d10df79af634fdba7f71fba7a795fe2041e1e5a8a939d082724dae2a356798ee7969d2359b6f8b48b56c27169d202b52ccccec029dd93d804e3b2d80d3e1c4135ba559f3b7e1930961b51905b4a452fdc2dd32168b456181de83e1c5e38ce443e488d61a45d4bdbe4a4c75a4e5699ed7f126b3f42ba92cee89274188d97a8e592fc7850a88d0fe44663f1b7b5f8911e70a4737263178cce52f4ebb71da4fde33ee5d7cbf38bd9fceafcf93f3463810e756a59e4d201b3506c16e43405f26c58fb88d41d0280c0b047dfd13b8187eef29cdbbee0b434dcafe1f75781d318c084d14bb9e2db528d58edbd7090d5693b7fdac32bee4704b5ef8f7db9bd7126c0d8e0977d2f0c4313406bd516d8f2755aff1d0085e55492e50a0000",
+		"7da2f60e29b0d15d8998a70242353a2d": "1f8b08000000000000ff7c8eb1aac2401045eb9daf982e5dde17a47a5ad888085662119349187577c2ec16ca30ff2e4921a860758b73e0dc0b9fd1200c2a71dec0b11d091bacccea957457d27f49038ff56606ee15048750e453e601dffd3d8d9c8b3e0e7a7337fb01ffcc28f58bb46de3abd1492a2d27d2253589968c0d1ebf8eadef9364ea77a2c5bd3a417070780e0000f4b196d7000000",
+		"82eae162996d86946dec74437b88776f": "1f8b08000000000000ff001f00e0ff726f6f7450726f6a6563742e6e616d65203d20227b7b2e4e616d657d7d220a0300fd93a5591f000000",
+		"97645b54df14a25f32ff39786504a8fd": "1f8b08000000000000ff000100feff0a03009306d73201000000",
+		"990b823750185434696cea251c61f62f": "1f8b08000000000000ff0072008dff6a61636f636f546573745265706f7274207b0a097265706f727473207b0a0909786d6c2e656e61626c656420747275650a090968746d6c2e656e61626c656420747275650a097d0a7d0a0a74657374207b0a0966696e616c697a65644279206a61636f636f546573745265706f72740a7d0a030024c26b4172000000",
+		"a04192e2312fdfbf97f2602bc5c2f61f": "1f8b08000000000000ff4c8f514b02411080dfef570c143d651708110b12e605f9e285a7f828ebeea81bdeccb6335788dc7f8fc3f2649fbe1df8be99405b361900808df1109cd5c074fee81ed277484c35921a08a497c1c60a2ed3c1c05e358ac973e5c106071eb781d06799c41468771679ab56b8490e7bf10d1cb90142f4a00c820ac5eb7a3a5bacdfcb6a71ff0f1fe5fc0ac655b52ae7050402dd23248c2c41391d6132cd274567d1403bb9449a6ec14fbf71a63ecad7c1e4f9ede94fd6655a73c12e6486c3c7a7363f9d1e66b6c6b67d590a2e2938f638d2d4e09ddbdb649d627a23c73ed06ed4e8f6b9af0926b2f5d591d18afc70f206fad0b8aa56e5bc68b3df01008fb5461f7a010000",
+		"a474a970f83d5b3cc7eb863b7a684636": "1f8b08000000000000ff848f314e83310c85e7f8141e8b8472817f010e80903a220637a491d53f71e4383044b93b6aff0e202a75b3fcfc3ebf57299c28451cc3bf6de32be538e702c0b98a1a8a26dfaa724947a51cbf454ffe20627e7f593ed7ba72206329cb5d0b759320e5c8a96bbc025e44ec0f049e6e0a50fb61e58061a5d6ce797f695b661c00ee7ad58c8c037e097f62262ebbbd9d99ef1f489ada030e70ee5f01afbdec6e91fde5e9e3e65dc04d80093f030034c9ff9a3b010000",
+		"a9f5d24020a76cb0aab4014cf0c26af1": "1f8b08000000000000ff6c90414b33311086eff9152fece5eb87dddc7b14b147c58a17f19026b3bb916c264c662952fadf65b72a8b78cbc03cef93771aec4935e61e0775a2148c791e6245117e27af38b98a9e3289530a387e4092d7844e7844d48ae39443a280439139e49659713eb7d7719e5e486ae47cb940692cc929d5d698a669f0441d09654fb8633f8d94d569e46cee59d04da20309e47be7062591ab04cfb9c640021d081da7c4a7595cc9cf70dd19f31faf0f5d177d74097b712111c25af0f66f502d75676d605fdb7e596959facd8cae8b7cd18f69ea635efd773fc540bf62eac2b591edf5b53d32eb62b07fdfc35ec5dbb2a4db9fa676d031d98df91c002f1e67659a010000",
+		"b1ea272be75e0d3124b02629a203dee2": "1f8b08000000000000ffb4554f4f3b3710bdefa71885a802a949cb75a51e2870e8018a42d50bea61b29e246e1cdb3f7b3610adfcdd7ff2fe21fb278400427bc8c67e7e33ef7966f6ec0cae1d219380f90e662a6395a095ff92f3d2e81498d66cf454d0f6b7ede59c182f93b5d42285076949494dc986180532a60980c60da55014d37bdc500889b794c5758b0e373ebe014c6ad4b60a51ae0108f2999396cba03352849e807109cf2b99ad407a98e752f1af60315b9300d40204596576243ab4d5e221d6476260032376398de25ba4025e11b83ade9e142483d15014e7520b7a816923777aabb7d219bd21cd1e7ebf689436f116982b4e61b440e5699414c5041cea25c198f60721fde30dc6108a422e3ae0e91dea1cd595b5ce6c5185d0918be5324d8aa273a697d531176a86d2884a7c64a8d41fe3ec2a2d0ad222665ffe2400cfc6adbdc58c7ad7ee4dee324a0018fdbade3b833f73a9045867fea78c3bf878eda20e1a8fcc6891d67f07f5366983fb091c4c02a0077eddab13bb7da12c6782cc0802cf3b4590ad285bc32ff00f79f69d5ccb9d9ad5e5fa6ac1e452782ab3faef540d6d922f6a286b897ec0f456e35cd1a3d1e820967f083d79d5d63ef43e8846375454024f56d426694f82b7a7417cb6a8724a617c5e1d99d6888ba4a7f6b3d634850ad08c40b831d99a1cc80d2e09e6e849c43668a603e3b2e34d1c1fef58f3bc22dd4e4f6a9b734b53d56f8da4f8184b0ed9b814e4c00c9fc253d5b7275bdfcaf12de74bb5fd506541be4ea8bf226446d678c9c6ed4248bfed563e362cebebbb296d3c3eb29a2c2acb8fcecbd66d0ea7f10d59d2c2ffad43384cd5021405294f21c45ba80beedb2be3c4efc7e1a847bf269f4a66df6547aa755c4798743ede1f2ed8f1672a76cfdb527c907de8c88062835a2ec8bf7ffeae063e20af0ef0642af74c6e4833eaf15c57c01046038e28aa6cc5f759ee1be89ee7cb8d4c5a8490fc1c007bde538e5f0a0000",
+		"b286bb0885ce57a4fc2844689585e700": "1f8b08000000000000ffcc55df6fdb460c7ed75f41a88fab9c18dd8ae2803e64b6eb19f96558495760180ceac4d8d7dcaff1ce6e0423fffb20397225cf1bb2870183f420f323bf233ff3c8376f60c484914a282a58681975825e7d260eca5901e87d38db0e9347654b0163f2da55866c4c0c452c31a248002c1a12b0db0d6ed0d0f3f38b2578947bf354c52b2c46b391b30f6a35b8fc90cfd99537ad4f13a1b1201d6a36a80fedd301148c56ae05180c9138099e64edcbe4b5921804bc4b00026992d1718d00188c727dd5a13d411c2263a455b58f889527010ba7b5b2ab7b5f62a4c6ce5d4bcb65f0e9dee21695c6429380f3eff67cc32b12304c002219af0f515dd100fa45ff4de16da5f5239d8da82c7127243ba17efb2883751ebb5dbadbc1ecfa623a59de5c5c4fe0f939edf949670cd6ffef6fe957dc62fa7b07435e851ac8be22a76f21cd6a0f5c918dc2d23726ade4a085c6070bd9ad6267eb4ef9e8d995e95be8e172df090f4ad3c7fdf7d901ab8c6e0efaf245fc701f683a9c8ef6bfcdd38fabfa0bbd6f8eeca6e91dc78e2af59b7dd76bee38d63a0cc64e3e12bff4e1e4c9bb40650df6e4600a6ec3928ef898fed850383e05c090715c094887839fa62a3d42a5df0848cf07effb805646fd03d511111396ca52087376c5a103f7ef3a463fa5784ce531ae059ca18c1b8c8ecfd6843aae8f9dfe952e00caaaa8508f4963959374b60c0286e7e7498f9458b9f200bfeb805a6de9ff5ac6fbd7974176dbcfb3bd84f97c31bb992ee78bdb4fb3ab49bebc18ddcd3e4f7aae005bd41b129036f7e224cdf8e7e5fc22cf7fbd5d8c4fc57e62678e75aa879f648a97542de8e1af683ba4e51a3912677213a233c45959641e43f8e6b83c11f4489580937827d75f6ef3bbe5e587fcf5b9eeaffc35fad7a65b16d93ee6846793239445b6762166b5aa49926559f2b2b372e2ad92d4db6adbe17fbabfa8e93cd1f4746340292904017e5368250fdbab33b45a390f21009e5d74d2690177a3796b6b3afd43dba9117945f175d3adbf1c8f364d926559f2e700e59507d40c080000",
+		"b3bb4420f8c3bafd8bbd81dbc2635313": "1f8b08000000000000ff5c8e4dcac2400c40d7cd2966f97d9b9ca1ba1611f402719c96e9cf244c521486b9bbb47521ee1ec94b78427ea43eb852f0b2e399e6502b409c85b339ce3d0e4b8a86c322d142469288b7a0f66da8e498fa2ed31c9e9c47bc331b5a5043cfc9c2cbf0ba194766db6ea1fd19f88954d78c83c8143d59e4b4a7ac6b7505a0695784a65b92fbbc3d313df4efdf15682a4085f7007c952ba0cf000000",
+		"baae059ba6eaf3a9239ea8fb0ae23fbe": "1f8b08000000000000ffd47dfb77dbb8d1e8effa2b66296665a7966cd9e9d773bd47ed3a89d3fadec4d96b3bbba72749b3100949fc42122a01fa5145fffb3d3378107cd94eeeeef95a7b9b5a2430000683790f34fc6ebf94c5fe3cc9f7797e0d73265783e160b80f6f0424121864a5542c5a71503c5ba74c7128781ef322c99720c542ddb082c34d9128c5734872823001385390e492174a22309e5f2785c8339e2bb86645c2e6299790e44a38b0723218ee63dbcb245ba777b02ed51e64026e92348568c5f22587cde6e7938bb393e7af4fb75bd37bc5e19aa52507b100b562aa6fb409c0df450911cba194086958818a44ae78ae369b7d0fbc12082a12799ca844e42c4def204ee43a6577603a80282051bc40a4886b5e54b391381d96032b0a766717f69ab322874c141cd85c94aa8d5a094cc14aa9b53cdedfb76f27cb44adcaf92411fb3e863894922df9b1790680c87a3f1e2f582af947783f1eaf78baa63fa4288b88cf5e9dbd3efd088b24e539cbb89c4cecc4b053928e73a1c6922b18c32b96a450ae450efc76cd729988dc2ca8cc25f7906a7ba79283f919c355c199225c4845641250830098049eadd51d2c44e16396b61ef4842d1818c3d52a91907189cb342dbc95008ce1b56031e0b22a62f0f77ece1788ec7521222e25cec427b6c1d0d07889e40c0cde9c5d81547729873489781e71b849d48ab631b653855ce463165ff3422504314a5929f96430844bcee1f5d98bd3f3cbd34916d31a71428b324d41f15ba587a4452512c49ae7a097f31dc04f296792c382f3141605e7a004224815c9bc54087d30747461c82112d9bee252c9314b6fd89d1c27799496318ff73331180c86f053394f93e818aecc9a61cd0ac90b589479848b9900fc5224487415f9290152c5a234930d0fec6e982d39679939691cc90d69690fcf935eee922b8538c165d35e569b07e3314b537133b6a38f59b12c911fc8c1d0c2b73f63f8891759a2dc549155d46739e710b134e5b1d923b7416d68006ea889a6a975a9aa4543cc1483382978a4d23b1a4853d29aa95517347d10f8354b412aa638429ec03b69c8256225ae0f29e2dbcf15c04bbe60658a34bc62d78928ba6692e80d4b529ed3d49739923bcb630d3ed6a7421f397d14edac3aceab7748596e3a1167359c33637780675fad78d75cdebcfdf4eae4f5e5e9a7b3cb4fa76f7ebafa7b27174699a0388b71de8cce120dd40590c6c6763c4756030a77ce6243afa3c12f5e1aeecc3a88afc1382ee92330e2868f631eaa6b928e88100e3215188fed3b33ab77783a9480248f93088fa11e28c68d176b2415e961583f4161d3351c4a2ec2c3cd8ae71527a79e52b14269125437025677eb15cf694ae18f8d29bd721d95d05cc1b1c352e253645b028f2b9ee6ae7d94c7bac3db4f27af5fbffde5d3ab77e72faecede9e7f3ab9f8ebbb37a7e757975dd3a7c17fc1a91321d54840cbcd3dbdcdc42a6475fc7b8001147cc10b64d5718b43143ce2c9357f8035d07f661b008f4c37af587127f31785c8fa0e419d263c9ea2c47d3ca31714bf5505cf90d51544c774c8ce5e7f7a7bfee9ddf9e5e9d5a330dac96afa87ccafabd34abad79cd49542395e9b032f0a7d06dba7fe11136a6b06bdb3b11350c8a278dc664e4eccaecb622d24ef2715b168aa1c48be6f2fcefe7a767ef2fad38bb76fde9c9cbff40eec22c96302fd6b267e453eb02c588664260ad417948025cf49f9eb1db3c5890643b8e0aa2c7209b9502be2c899d8d9859d017630ea81a57be0b73c2a512e27393090e55cae789a821470f6ea12d5f3824bae26a62f0a67b96691e1116e17a5801b0eb1c8470a51b04893c8308a98cba4e0b1d159359c54442c854cbcb0f47ea87927b11b09b128e729ff9be62e03ea71f6ea72168ee043fe418de801b59cedecd2875a8719edb7ee962ce0fd7b0887305e2a38808f1f7f406ce7038b3cdc59562c11e341f863f003c4c2bd33fdc31af0467ffb3b849385e205e280e7912873fce0b1485062c9d58a177bc050555ba1852355071ca3f8381601ac709cdfb6b23f060741b8a1bfdefff8711b4010b26219ecd640734488fd607f22d406756b48da4bc2dff1eacb58ff8fd33fa9fe77fd65fce12fbb838e0ef45f26de212942101e04bd8df86da2e0a0f7f50f3f0c3adff5ab78fdf319029174b4e2d16734af906067972f0e0f8efac7bf47eacc5451f2414fbf7b26eeeb69bff9646b2cfbdb67984afe3b4ccd67dedf3a37a35c3ded9f9e6522b320dcb06239747db64137d08a418c171084b67fd066135f818de9c1ffeac706fe4efca17a5b769e58ff97472b01c1b90059462be29bc7e02de1cfdf1fdedf1d8fdfb4b7c922f9862dba8f722eb9424d38654baba4a2da77c347d71ec7e4b161fd966df602ac33fc6f22a97b286908a7d7bcb8439b8efc3d280573e1168032c3a8d52057a24c63541fb49edf0bd3f0ea8a55ffa1c6b6b79d7cdbfffde187d62b2e5934e8d9b658e4dc084a4dfd99f82b5756e056a2b72e3cbe7c81827407431b99f80955770842d723802000c2f82eb900ce50d6e52c3d861728da98d3a88d793f4563c03c42650d4d6a34180e610c7612642248d4abc22318c38953a72b5599d4324077486a35bbcad7811eb73987181d788528e51e70b9e65182360e240bb81325c9d112fb83624b0969f2196dcccd468a8cbbf9fd004506e36201fbb0ddf6a952b84edb636717363595e6a4581a9545ff8d2acae01e7e71787034f55495312a229b7ba4cf78dbc19fcc50e1516dc7894510c0c300be68cb2008a7a822e80ec160dbd8c24bc4a4b542ca5c25465749962b4506a5c2032c6121ca3c9e00bc209d593a37e46068acc39a7597f16cce0b63cf01bc3ff80833b7f7c60636c0758329363835c3ed44025d9168d5b2e5ae6e70e84360a47a390003737c49f5b55b5e53799d011a48c5f298a52227e03280542c93c8a35ceb8c728aaeb505ec727d3ab6f46b3b556b0a9fc118ce1630fed71ec402eac3face43838d459227726569bc83065f25797c9ac7576cd9a040bb7905cf5892234819b13ce7b137e8f33bd4f77166d471780c084ebb0b110df4d4749b0581a1e6cb759a28471c417818c068b319c168bb1d698abf59a1a7e3fd7b24e1a169a8f90aaadf53a25b4fc1cec4559164bfacd03fa84d0ab6c4aeb6e7f4a32fb987c770c1a542ef53d5c0e2eb067902fa26f4762795665db59de16c1be0abb93b8d382412afcbfed170f414bec0e81fa30ea9313c864bf28b30dc1f5ec03c15d1e7563387cf7063fedc567339f8e87da075db6e0f214bb1e5f1b4b37d452216093e720f715b025c6c00412ac43af8ad67ec287056353cfcb81d3c24a047fb3d584686c03476610c9115bca22ce4ff37baaab59abfaa391fd4c8d0fe6a766d10389b41101e3da038e23143b609a2acb8957dd9a193fe0be7fb4cf37bf8fe7bc8c499bcac1847f338bb9907ad6d46697dcfc4cce46cd480a5125d2a157cc2dd18a294a313790d370eabf78143475726aed1512024f73ac1fcceb8cf908f0684409e928bbb175e63b1b31d081b8f6077d0d3d7670115291f879b060424e93a4dfb683c3ee8e831fdb8dd06bde3b6cf4f2790837b81543398d5b6f5ab66633582e68ff6c3904ea0092cd7fec413146e5657e8222b3cf04d32eb1cc168930783c74ce99e43f89b72986ef67266421b690a9a8f3b51d83d47bba9bf1753b47f9296ef3eb5e5b003e9cb6367025809ff32895187d51e47e440512aac363c7800f90716f98fa2178717b21482b692f9ca3a3d17492131841ff35b5497b4f791547b0cee2ff47c9165eea1a7571a8fb86eaf048ca79ea2f6924b95e48cf4bb96b2465dacb2f637768741efcf4e5be33c4ef97d8ad625cda9a168ad85b476083dd57fcfc2cde19327e1d1532de74817d26f8c9438b4ec7c2de46c3c852f5fe8af7033d4cdb6f722dae2782d3a94f7bf1a5731308885425f3ac6e0315982c7207288caa24092a16dbd55148a50ac5872450d3d645ee9a70e8f180124cdabe0b24c95ace9bdb7babb45e74be7ef359df5cb1eec96697ac596a8303bf4fae2efd088bf2f5ff019e99cb3193c9d3c6d8bda875076140c5a8e9587fa6c0eb793707364a87f91b44999ab68658c097b226dbccb84641c22d05cd2762a8334910ac46230c4a62a61299a5485717f8ba2068f824152c549ee760803203fdbcd41fca234655226cb5cfbe56ddf398b3e03d3fb35fed184e568bb24ecd838e06edfee540e8306edbba999209f2123cdd1f5dfb3509bb572952c94b7af681afcb80dfa1cf206325a1dee99c50c4ebbd753ef293012037329531899c300bb841b517cd6784c7299c45e9e11c2ad33793783d0fc158c369b3f8f82d04e21700cb6e667a9115626306d0463a0161eecc7fc7a9fb6b1e565b1b2d092a3c6609b26edf36a6a2d823ccb63dc186658d31eac5316d97c89c4bca4003bccf932c9511421efe5e8f01a0c214d50df439fd70a1d2eb9cb43eab089e32e964b212be51c02081b613a967155cdc37a7134dfb8aa22760a37504fb68f34f53a5f23e43eda2cd812754a48798e2cf61cffb9c0b00ba63ca822c9321e6bbceb678ee6c8d234aa2b87352f1211bb90ac0dab57014613d2e2f92cdcd90937c3a32d8c61baab750c339b59b83942e52fe5f976d0c7e5be92a119d0de41a9295b96a87c11862838f7ba4238fa908f3a9b5d349a156d1b1f81e9833c9efa4c1af1ec3daf1dd564d1d9b7b174fccfee9fa7f62206b123fc019a369c695169555e4bd7b07644ef1fe3e2d1635cd4c7b068af8cd0172b56484b70e8fc37830640f116f2a54200846535c27ff3918f718f5a90f719403dc6a6b7a2c36aa4ced959aa0ff51f5e6bd7e491b4f335f483bf4354ee7c11c7f3d8c49f737e83ec82bc64a8fe692e30e9a31ffe4fa85c4ddb6e940ce152fb824d1e1d49da0fc5877a2b0ff085061c843b3b388c3ecd3df8c6ffb0cb6c5c0fa6f888366dcefd36fefbf6d00faea939e4226968fcdda467807653de60f0684a337088c82ccc1ad369d2966b34780c67d3bddb0aee25f25e56c99a725de5d191666bf832861878a1b9b4e7f7f544584d79b2a2e967cc50a036a7b70c5dced238ac23e3f0071235c3c7f16797a71b108c6d1d12ca4e7a424efa20c4cf01d22a66397359efde2b02096b4efe51d20d8caf6902f4645d24b95ad847307a22476482f460d6ca6c26db58ae705a45787ad5d14adfd496a171efc8af456e7647fd673be8f0c2740ab52a38df7d700beaf6a881429ee8c7ecc5061f506b6f3f6a3378704f68c09e8d31d1a0b35797949c8d0681cb63320a101e17ebbd27efa5280c10971ab4108501e4a21120d32432fa1369bc055fb2224eb9945663b126200e2e4d26adc80d20ccaa87a30938453ae68a2598ffba280bf28830659a76e4092f923861f9fe72398e44c1f7d7659aeeff899a9bc0d38ea1c6007379e0899ced7c083e849b1f8f0fb71f825dcc369a3df9676077edecd565b0db66012fb9c2b4dd9c63588fc132b9e67967f62046b825860df3189b260a12d441ad5edaa1ce7641a156cfb94d924bef284d6e51a613c020538ea071cf220ca9c61cdda73a9534ded343e281859540cf524cbe0bc8f90d2fe09a1798994b1bf35c57339ce4773ae90dcd49a6202ac41addad2b5e982c398435471748c44a0cc8b152898ca904cfe6dda4eb705db362b6c3e6913e32c90299ad24e2444419ae6e4e93e64476ef2da25cde9c7efd867de620cb82534c55e77bb1284a504ae32c30511b3e84d7acd01d1749eda81c20c29060d190b2e8a154bb3d986ab7db4d82a9a26e9eee107d4beed90d1a32377c54a0c52755a5ac9b60ed1597ea82848d939aeec92cdc89799462d878bcd65479f86732e2f2324d775b461ca9be1b1fc2f1c1f1f460abfd3e0e14b3fe9f9ab2fec8ce27adce46da9a79dc7b5a10edfabc58e43388f922c1d8608788743171eb5fa0c8f57ffa81c884a80b9a06d53b4cf852db9c1b87914c88fad131501078275a1f77162ac1da7118ece0ee3c68d9677bbc468792fd80074513b4ffda27e857bbedd73b106efc07fbfbaef902f6b760f218507bb6cdce9d47a6d6936467a7d9e777340ed1698f66ed48bc57c77d3cd99bac855650cbb946bd90d6d0c694d13daa530b0c087d387e6212d307b467ad4360a392909794f080a8c1be0b516458fdf0fcf4d5db8b53387975757a8124813928101cfe09a607812d67a3dc28e351d4605044e89099f3f45bc0819e2ac23213d939fcd32ec9bcaefea82c1749e67ad3ea02d7757ab08bf9d6ef245f94295949e64dc6f2645da2474de4c71d4a34ba87b9a21e6bf442e64b5df032375e762f45c222d7a0d43a7fecdb1abe6dde049a29fb64b11ce33121e6df726079e6e45fba0f7e2d7a7a717af9eef51504a1c120a65fe1d8d634aab8fb019d5dddfed3c9c5c5c9df31fa68faefd6f4460d0bbd141bbf3d8685b693c924dcd0f29a6f7510b14fa1f426dd38fa04eccad8767a68fb295ab1a26d07d6dba01dd06d0736fbd5064247baeea0fbd6bbe1c0c60d561bade612a3369bdafbe3107b1ad758cb3d6068264e6214f168aaf8be02cf6c0d084c00dfcd8c1b454bcbd69b62d4e639437b6c50b8b0ca854ede785486f5e83acd04a9ad96eee799cd7a0ada1ff61d84cf3a78db901e69b846243679533ddbdbb1ba0633b4f6368e3933f465517a703ced4328b5835870592d89f2b61c5209195a6063821cd1e3b722dabca921a785fcaf408841c6610d03f579cd5aa3070dec4c316bc6b4cb3bc801e1cc0cb8cad365477bc0f6ef3a01bbbbe0fcc37f20af15523c8cf14f7f66dbdd0eb3e77f8b24877dc0aa65d4aaac726e7971db02f7ab9370e7ff5b90426208007b1dea5e089963e55f78340e9fc2184e8dad8e10b0571f67c28e0d8e84cd7941212de767ef0d0ce9c6b3f0d0c872547a6d0623058de0a85238086457e8a7e968d250436c1f34d485c74757fafc4f175855e8d7f3555e92498758ecda07ec3b72ccc50ac75736baa504a482c57d38b741a506de0d348c74d8034fd1145bcb535034036dba3b50054b529499e6a06361e02fa4a244c93cbd0316c706044591ad83cd0e81c14d2c082ab0ea2d4d2b28a6d3895663155a8862ad922cf917a90da4fddfd894ad1b516015aba0601ca9183445cb63d0c0c510520c05afe2575892a8795481fb80396b93c1c07747863bd86f3c261f171e4c12cca3c9a86dad55e11ad3b926a15a8e7e13bac1a999d050caa442043d8e1f18386d92fa49a77e02836855e69f4d05198e497cd753e15482f5f4ae9e5a946a5d62bd68b39c1ab941a550b96074c1d798aa1ba72669151b5db125eee622b9851d9b148064885a4d7844f1e2735bc2a92f5aa8ca58a9a4d363287d042bd63dc46a0734ffff9cd99a2dd3c0f209efbd65169a3d1c76249dd623cb1e9fa8a51a5898766334f4a0f29bd653dfcd84dc3ede2aa338b9b655909bd84d6b9311572e7511f792dfaa3d72f5dad0ac6597adcd1cc373bf17b6d0175538c5dab8f470e360076139c7c8f9c99b533cd9da0fb2d94cb65bc838cbe5aed5bb4991a342df5c34d52d3c717b46cb73c668df4613aedc4e0fe1ff70be465730aecfce4742269e6aeb5d09ec4c175a201638e49cc75ed2883460a8ecdf982bc618d12cc1f8932bb2d219ed90098daeaac02113164368023c77364326cef9adaa3fb9b229d1ae9325b95a5f145366821d89d33643ab9a02d2d5bdb9d2aee9d7644bd374b17bd5bb9672d65aa05f1859a53b6b308d02404c78ee4892033ccf7b902cf6515ddb0363f039ca51ab4294cb95f30f7440b8c4442e738c5aaf3351593b27589fcde31a1abda59a5ac7dabe048306bc7b9176d5930a8cf142dcbfaaf4c4c5fed18f80e76c0227555d0a16f0a34f000b7a3b80595c74d46fdab20d9a54c7db2bb69c99993e790226a5ec9e2664877536d3e36cf480c74471576cb9ddde9f366e0f53bdfa27a4d1ba70edb3588b687792bc9eadaee46043dfa76bd3d0c6fddf215caec40dec930600fb84fc87290f3afc788f18cce483bc30aa0aac93b565d95a022343f633a5ee8322b5e84c59368f51bbe2c6ffad2bb0eb05d87fe905e4f66216eed48b91dc823457a0cd43e78357f1b33be881eac9bbc666bbfdabcbbcfe697983f7e605f3d46c860dd53d6a27742449ab1540d1236f951f02f860e0d09f1fc2cd77e6686c91af7e30952ccddf5656483756da087588e9ad3f3456baff6b2dc86f435dab56e2cf7d7c9ac536b510c69491959b30175a20557e1caa1337710b022d9b3a7f0dfbad61a50366531ef900a71d49d0ee6dade54177ba74ed594f0d09bca8929e7541d60a7d1cc6f180fc640f434912ed6c7bd74d0794374c452b03460b00ca5246403efffcfdc55b6bd9dd054aeed61ac935e34b16a89be19d31b40c8f132552fdfed3fe16a9fc3f2698befb0ad1f4286e61fca6dea3df9b497cd7731844865a348c0da53b15c7aa733c5749c1d3bb8ebeb8813a3741ee2b36b7ce65e32bc83a8b797e77f29f74aed268ff76513b9dd9025e506bf7de89bfe47972ffbcbbbaa3c2d222b9deed6f6de06cd4b932b20321e66992e11581b2eb5a92fc4e47a4443ef64ab024ff6789571a49907ccdf07e9918e6775e91565b5935a5d62694a629269edc8babdf6593479b6e6a7e977319b1358f610c924a55450edbed9618db767bef44bf6953cdeb99cfb6d0bef39952d7a82de3b06a8eb53bdb5147a77e7ef9a259a3d3a5f8ff7b19188f64c6b687fde9d606506f680d32847371837733dc54d7657634fabfa550dc4c9efc122eedf49ff42651804502c5e4be236df4edc60a10e42368f9fb0768f9b7a7da6f92bcdfbc63fd587a10379d8839c7487a0a0fb170caedfbed71f71f7c98fe4da8bfafa6d19300b3b065280c1ecb3d7bca1fab8b30bc3d2686d9ef8e37c693f6c3ea6a13d6bcf165bd4e91888c475c6b4bf58e243225a5b7633204b50be88e49b3b440df8933e711c3cc397c6f422a2648cb28b7c392ad858e71d948e4b2cc783c812b74efda6e98198797d724d95a148ae5c6bee1902531d5397a318609fc82c9a7796c6787d9d2381f8a1e9a9b38fdeb29d0441a611ee5afb53dfbd59dc23debe470d73663e8f8b344b30a0d251a87d91b3e6b191c26b086611f7fc1d5e038e9242f31394627b89a841c1dc1c0cb67ec2c64477a6757b5d2c4463be94e1293566270b347d79024b6ea94e8afca44695d65942cec12bbcaab2cbe9dd3bee96aa710b74e73bb61da2d74c795bdf3dabaebbdbb1c6c72cc8b5a48df00c2977ffcb6cc1908ffcb0b2a1847ad49eaee75fd234976266aba0d716eff75c1af0d64ba24504e6a4e7cbb9c4cb898a82df6aab3899a8ba2e2eb908977b92e1ab1d55dc6d1546be37da05861103e43cef5c7a671e7b7c4b49f5acf4a40790ce690d27ffc768d027fbb1cac14ee6ae93534c898859b67cd96d37a4b0f31d56d5835c7961b36083aa67d18740dfbac6784f08fb564880ea5c26d5e80856f537b810626ff3b5fa7bb6ad28f29bb1ae51bac47887d494f1076dc948670e64594f32432b1768acefe1a27058eff2b0141ce5a2557dafb81f73c507374e4ae981a619ecf62914498bb4071b13b5162fab0c8d33b933d6beb403d877e84e9543b664cbc9e37082b1c04bb74af56e3c6359f4e674158adab513e5a9139492f0b74387cbabfed82db724398eee497f92fe3abacb51ec2abe416abc457b062799c1ab660b707d30662f88cf13c973bd067271a81479786e23e18979857ac596fef087052d15f0fb63c18ded1473ceb37b83c1fa7c1a03548e8fae997bbde40863ccf4cc95047b2285e1bcc2b14d4a5b465e0890d5cdaf5db544cc3605d218695e32430e218d3a36f5649b40275b7d6f9fc0690c92cadd2dd486663b814c99d6437032cfc66a911e2a221b40d2014dd44e3e66275ef406b618630edbde79860a52f0d604b8685e704c340ba57563245d026f7a645d4ea731cc3e9700634f533b227584fc58728fcb84cad52094373c35a68fd35fa4cf64df603cbef1c1889250c718702d137a61da31990b7414e23bbab88a10964e3ddb2d37ade779f88c56577ca57b5e2d293b235518a095ae4d4400d08095f0e06dd51b06953ecbd787b7e757a7ed51560c22dc38d3af2ae2aacf88de91878d9a5cdb4bd6675b2d5e8dd2ccd2093a0569048b72179adde4f3f76564d37c24aada5b93092ce60abf014ec058011a570da36ff7c1682bd6aecc55fe3632efa6b053d7f66055e8fd933d9faf89bef9a263a4fbddcd34df32ad84e1cd95f021b9cfab771a3e229b93a8670da7d896883319bbd6cfc59d33c86f0ca948fc9728eecf996eba0095e82a83d84ae2ded4c60974b11befaae1f7cdcbe6fd2c1932793a7db2abed7714d8709e8438a19882eaddd5e99de2c08ebba4ac61e6e53e08cac1761dac34d17c0212775ce58ab9f9fe671edf923aedda0d976dc365378954a26ef71471fb4dd41570936d2836e67a31047b5334515c7018c73de59848fc2dcded1534d1fad138a76b9766688e9c799371c95c6eb3cd7a3edb6d9f6a0de5697dc7b7e3c9fa29ff592f03deb35f7533d0b06b51e8f5ab7fd3549941c6f74bf1701f6d78cad6fea7213f130f1aceb7e2ed7b0decbe02478e0a42d92fb13106b92d682ffb1cbf5f137d4fd6c00c75ca1218a961a64cb43298fac4adb1cfa17bced201731ea8adcb5462e2313171522af297e74aa8d76796095a61b89610afa1e3029cb8cee33711a8c7576b8d4347d65ff03967f60d616b893fd1bdae47ae1a83eecfe5ee6790fc768865c1adc83ca7ffaace1b9b93ef01e33786e2fdd9b37aedaf30493367ce71d57daf5d1a623cb67aee3d475ac89904e43f521b0cf7ac5412fa9bbfdc61a04bc12c7bf21164bb4ce518d64f17f975255d95f198b2981da917c83c8ff3348f221cad231324758ad2d79c476f4d6e949bf14d26625d4346354b46d1937f944ab6ffce8d2cb31a91dadf7f67787903f96f2a2eb50aaef0dc9ed3786b8cb30ed378324cd6f06d13bfb12c3ab846437be4e55c7ef5fd316867580250b14543241558b99136fedaec15097d8222ed020415cd82a76e3c2f5966a69aaf15391d8e3cc95c64f65bdd0fa60876e0a4482b95548406d7c3ee29b5828711fbf5284ddf9ced4c68f51caa897bf031e57efad9ceda922ef23022cc4273230b32b0cdc6913ae99061e04cc4e74c43f04fc582b85b5b9bf0dc3aa5dacfd9081320492d9525f0eb1625868a100bfbb0d9382cd4d1aaeb55698dfbfd7f96ec3703335b96e2e0dbe3e850eedfc6cd1b1ad157e786e7b3bf4d82f43f480d4f2ce7dcc7d8d5554b762fcf954b7e092debaf96e3a36b5f186506a65f1c69d633c8e1de7c02017c9666ef486f624f4188d7a7b5fe772a36d7bd81ae2ab2a114282c2ead8658ede1e6e8ae513e53f77fc6530f4394c775d11718bfac9408d02760e76ab9daac6267a9f76bda1ab452c916b6bd491bac3373308a70f73da8f9660453f30414f398beb8e42f2a0bad5408f2cb4b3f26a545ce63f3e3b02a8e94df421c960510813bd78d6d382e731bdff2395b5bc58b182459833638ba2fb04a02b836daa54e86bb3de6a1a5e9704990b35af595155e1d70bdfa27a6501f5b5856fe8c1b37e7704676f5fa702137856951f69907b06357b1e16c8fd661ea077103fb64a0ecc1ca84c330871a4a0596f800f67b66175a090e799c5f5dec518ea897cffbd5b6b106ecc9ffbc3d074f73444fc2fc4b5f7f479d2d1c75dbcf82d157e06705b25c1ed76d48b6a5c87cf1ba92d73b5ffde09b514ddbe375599eae98a920f1bb4dd4f7d5560a54182be3fc0afd736b544a899d141d0ffe87a5877bb9c1ae1cd5f8fd2dcfaca214d5629aa31e68b624922666202f05389910392aeae7a020327e6fb73ed8509dcfbf6332b49cd3da9c82f28caabe330d5d750d8f80bea4b02abd38cd584b68cadd9d8338e77a688efc08aadd7f8355c048abe6b0d03e82c4d796cbfb2e2d7f0e057abc6e87927b5b24e1b79a96ec76c7e0569df26d2b761b9bd5b167c0da37f0cf74746d235bf1e0ec33a10950ac6113c1b57cab665055cc1170de51f6fde7efaf9f4e2f2ecedf9ccecce253385a6a24896097e834a24b28ce5f148d25da8b4437aaf28b963d031010c4751446bf3fce4f26f9f2edfbebb7871fafee0e393fda77ec8e90758dfc4bbfbcd563a3235f0a6161c4e0e26e88aa1c27efd254bf11e15ba520da953a1d0143f5bd87a88780fefde5c8842dfbbc9ccb7b43209fc76cd23c5e3c9c03a740eac6caacfc5bf3c78fcaf9ef79efe950908c21f83c12219fcbf01007eb64a08b47a0000",
+		"be96937edfa4d2e97c002fcb080f030a": "1f8b08000000000000ff4a492d49cd2e51a8e6e24c2acdcc49092dc8cf73494d4b2ccd2971cecf4bcb4c57b05528292a4de5e24c8671d33273528b35d4211af52a7373d435b9388b520bf28b4a8a41e67056e4e6e8a5e62526e5a4a6c0757366946011ade5aae5020c00d3fe98e481000000",
+		"bf3ae73a3da158b833593192d56b59ed": "1f8b08000000000000ff9454d18eeb34107d8ebfc2f2532a11abab2b10ac2852b9544041b4da16c4ab9b4c8353c70ef624ddcaf2bf23c7d9b2a505719f329999339e99736cd976c62235b6e60de0c10aa91d3f195452f3da8a4a0147e14e8eff34fa3e9ab6930a08e9545f4beda82799ac7216f1aeb352d7472b5a381b7be2076390cde800d649a329f39eefc6946f8dc1df923704960a4833e179051de80a7479295aa1450d2de89b3a4f7ccebfe22fab9f57cbdd8a792f8f14fea47c2bca93a8a5ae293b0bcb4220d95958ef4157d14e33e5ac19dadb621ff8174fecef781a6c6ae641e6781e5f697150b0de2e43b883369d78884b8d04426a6bfa8e2ec68d7c1fedb885b7fcd11d8fb86ec8fbf736280721ccf99c3f15bb5f96dbdd0f9bfd549b91460c823bd3db12225302e5412a8917baa06b3188a90c473359796c214676f79810d88c100b9d71128d953092dd8a01f447d06885ca67241072e52b65785f502b740d947ff72ec265dba9914a81e35424f39e8730e64f14dda6e4ac342d3f0a87605f5bc51b519e9cd1bc3555afe079fa2dd26f913860b3fb2a8fa4fd9c3e8585a382123f15e6b052f25034d5e94b36f3fed1b8b6d7285bd86875b9ce3ad1f45f8aedac196405d54b82fffbc57a4ece22da854361116c81a62d05b2d955f4080e7f7c30d8ffaf082ede3d4fb20c5e4bd557905fd51b5b6b7a2d910f52a3a8817d46131b7441d91829a64801ba961ae29ec3e37ddd77facfb545ada5a7e82cf18ffda583aff7e0f09ba8cadec1fa572d71ab041e8d6df3d983ec9b176c842556375d3c2e6a37cb8e1660cab04b5b3bbaa04a3adc1c7356fcde38fb61fef9c2a19549335933b47b616bc0e936a723d66fde1018c90209e4af010015cdc03966050000",
+		"c137a9aa92bcf0fc6415d80c9e8016c6": "1f8b08000000000000ff5c8fc16eea400c45f7f3155e3d76093ca4b6b284aa2ed8748584f800337160d0643c781caa2acabf574c766cafad73ee0da917740e80728ec1930549e80000383d824a1a381942144fb1c6672a7cd2887035cbd8b6f5729562384dcd91f5c17a10b57976ae640de9826e9a420f7c87669fe81cf9fbf005a623cfb303e8c8a8c8a89e17ebf844dfbab3c7e1b7dc23b6ede6ff7bb36ed6cd06b7dbf55bfb62f93c153ea5e0a5e3dd13facf5f49c91beb3e79e942baec46eb3f1676614d3430c26a55834ca5fc8876357000b74c4b8b5e74203bde23d6a66e9a38757552553f9fb2a821bc6efe1b004aea52e34f010000",
+		"c350506443014b0c5843f5244ae6d73e": "1f8b08000000000000ff8490416a03310c45d7d629bc4c36ba40366d76ddb4859cc0e35106118f656439293573f7924ca181927667fefb3cf355423c85897ceff8be3e5fc34ccbb203e0b9889a179db016e53c1d35cc74113de1206238344e23291e6ef0b994c431184bdeaf60f7afe1420356d27322c3dacaf5bb6fdb5ec40e2b79c96c1c127f5e8d50da9038fa9842adfe77c3d387511eabff4be33b807b7a3b932a8f04aea81845a3d13f9ae2a3e4234f4d69f3b0127ea2adefe09c9235cdf73956691aa96e7ac73bc37a71bc6ddaeec02d000b7c0d007435a54499010000",
+		"c757e3eb5e5b91793cb41d6b01f0fd4f": "1f8b08000000000000ff5c8e4b8ec2300c40d7f529b29cd9f80c9d59238404173069a8dc4f1cc52e2045b93b6acb02b17bb29fad97c88fd407570a9e763cd21c6a05e039493627b9c761896c382c892d64a4c478096a9f86a6ccb1bf659ac343f2885711430b6ae8255a781a9e37e35fc4b65b68bf067e22d535e32fa5893d194bdc53d6b5ba02d0b42b427317eedcfbef41a8d39f5f57a0a900155e03003bec9d8dd0000000",
+		"cbbd434cbcd44d4f84aceff85c49e381": "1f8b08000000000000ff8490416aeb400c86d7d629b4b4373a80772fbbb729859c407664a3c61e0d9a19073af8eea571a181927627f8f83fe957e4f1cab360adf47a8c2fbccabe03e81acd339acf94a26b9827e7556ee6571acc320d45978b389deff05f8c8b8e9cd5c2e9007f0a6e325012df16c9944afcdcf6253b99e5f341fe07cdca8bbe8b038c0ba7843f09f6f85bb0edb00234b689bb5e04a71270b430e95c5c5afebebcc76765bae7082b348d4b2e1ef0c145c98a8f92da5ae921747cb7efef55e88d37eea0d90176f818005a64e0608a010000",
+		"d06c60a87d13ef07c711e662e82f3f6d": "1f8b08000000000000ff64cd416bc2401005e0fbfe8a61ce75f223620a1e4c162ba5523c8cc91836c6ec76d3d6c230ffbd184a2fde3ede7bf09e77cd1654691ddb8be4324ee7d0d3e6cabd983957bdf9e6a57ae8ab9f1467e97ccc9f66aedcaee11d703570c627c08253a2bbe1e8aa7abf3bf86653efef8b81bf19e1e85cd9f80360bb9ce13ffee2d35718bb620ca7b950a59aaf62b652a557c973889319a98633c80790e7f6c27d987ac01b6734bb7156957116b361e1d4992160c129d1c0197f070050a9be0eef000000",
+		"d1366725ed088ac91de68a598d4c46ab": "1f8b08000000000000ff548e4b8ec2300c40d7f529b29cd9f802dd74663d1a21c1054c1a2af71347b10b4851ee8e4a59c0eec97eb65e223fd1105c2978d8f19f96506b0bc04b926c4ef280e31ad9705c135bc84889f114d4da774553e6385c322de12679c2b388a10535f4122ddc0d8f4fe357c4f663e83e27e06752dd4a7e529ad993b1c4bd665bab2b004db7213457e1debd1eff09f5faf5ed0a3415a0c26300bb8ab11cd3000000",
+		"d37e805da199b44bf58ba3d8b3f7c418": "1f8b08000000000000ffbc565d73dbba117dc7afd8406c99784231e974fa608fd2ab6b398eddc8d6488ee34cd85a3009494840800140c99a24ffbdb3e08728dbe9f4e1ce753413027bb0d8c51eec41ef595c5a13df091573b506bb22a4f787fe915e8ff47a00a78665928375cc38280bb0a91185838536f0e1e2e0a682fd917f84f460e81ccf0b074e83e50e8693c9edbbcbf109e9c1945b2dd71ca4505fed2104af20675bb8e3c0fc14994c4f07347845490f2e38cfc0ad84f5c11a2e99136b0e769b23d2f6c966252487cf10ad800693e929857fc311649a0000483b984b0b91cc6ae3bc9a16eaeb60ceef0b03349096c22184fd83e80d24cffb07c98b20ac6062010d46a8af1e15f70f42780371c6d7b12aa53c02b7e2caa3f157058e91513fc7a5e57bc679268c62396fc2a17107bd1024d38a93d9f0fa6434a0f36293cd294933a0edb2c4af4be83ca6f0a68d8234675b2d826852af0bbcab3da8c75e0c115bb182fa99df87b3936a7a7ec72caf637c45e7be925906195fb0523a38bf1e832e9cd0cac28a1bde874fba84942960d26a282d87f3e1f5f0f672723503a632389d0e47ef4faab1d350306bf79c385dd5b7e2649f8c4ede0e3fbcbfba3dbf1efb4583904637f9fd3ffe9e53c02f8b5f2186f5c1723c7ec8d9bdc8cb1cd89a09c9ee247f09da78ce8d8737b76f47f06c00d16be42146e756ccc19ac992f749651fd0da032564c38c82e72fe0bbaf084f571a687040c94f4232c1f72d0f20fbd3f7c2c16b5cd683cb19d882a7622152b0655168e3e0795e5a87940f9d29798801870b262d0f5ff449ba5d6e841af831c9edd6d69f1933bb79a59575baa84729b31ce8bcc4c2cd2908e4e4f1a7d38f671707f0c247543bc5edfcf8e888008cbcc70652fbdf878ccf2e4e3f36081fccbefde2f26276753969104d585d10b72c25e4f8fd70369b0cafde0d8286aff1d25330de185614dcd4c3a81ef6bf30438858c0677806d10268d0baa86e797bf7b034f4643abd9c1ec20e04c2422eac156ad927645a2a08ab0da0de00a2a8de71cd8d155ac1f7effdea565c57133f7f86a055ca913c99de28a95906c2f52959082ced883b6e72a190561cced99a41aaf31c79dfd04dd76dd703aec7fd2aa148010dfc45c17378904e85b8ef22e22f86c776157f616bf6008dbf1e9cfd3e0e2d9c8ffe055ac1f0ec0637b7609d616ac941ea94557716db2886c2ef795a3abc2db6f582f11c8f47835fedfbb8a73db5e24ea80e7c2176293ddb25753c1e3d9146b78ead432c235e66a78129106acda4c8201386a74e9bed21ecf626642239de048f5fd5ad082db0664660b620146c756980abb5305ae55c79d73973e90aab489ab302bda890beac4259c724ca8f56fd36b5f630da83d865be5989740538eef45ff8db9bbfbe861f3f7e9daad2cef72ee490d210a283b06555aa4b9961df58e852656d3248f7fe9f977d45fe33951ace1e34e105ca71c6ab6eae8dc5ca6f380a44cd7c1a549d88c200a86f5e14220634a8bacfa3e9baa174e7f77853f5f0dbf767e3b3abc1bc9422170ea27710a956c53f43f04f88f83778f59872754c9517bf49230610e947862738bb8b61d0a0ab60680ba9ef00fe9af814d4d8d6d2c6a9f85371e23f2f4ef4d853a0a1c92fce1dfc3e87cd2e8f62d9bbc50ffd7e2bb9d9fe9f9ebbb92e44cd8cb7dad4d2f212589675b5be52c22dacf4061304561452d494c3a6cc9032ca9b329d7e45ced4cce81c48e74d31a041f78591d0e806d71da210564283af9a84ee2c22d5aa234139cf04ab95a72f526513da101cd338f664457d1ecf3ecd5e82dd086c1405732b9fcf47a132bdf17d3567a8e80b6d38985229a196fef63f417b14c79a5fa8a89db9bdba37310ee6e976893b4214d5ffe5e29e6740db346845f656fbfec7921643e77badabb3a254a2dba8e7f5799cd894150f4a66969658b6ee3c8d506104641a0a23945bc05f6c92a0da090a3fc06208360ee3304992240ce3e5d16b1bff270ee3a324b07112c421a025a6fe15af78e7910594fcf48fd5e1f4743698fb5d69f05bf5463dd652f2d4019312985996d8d7776af7a5a3cc2f61a1a5d41bac0fdaec8a4b09df4aed7006dbae2defac13aef4a434254a245f33e9fb6a1441f0f0895a2b50f5d965234d6834d266d9afe9c58a62c7cbf6c99d500a512a99b5fef869d2a911da3a0e9a9751f548f9588dc64ca89a0a7832941014f7aed0d2e0374afe3b00a2ae06226e0e0000",
+		"d3f75a44f29b50d0955a34b66cd5f4d8": "1f8b08000000000000ff548ec14a04310c40effd8ac01e56079dde07115cd093e0c1a378c8b6e94cd94e5b9a54d6bf978c8aeead7d24ef6507871e9367e8b5649085c053c09e045cc921cebda1c492a104f02474921b602258442a4fd67eb3718eb2f4e318cb0fb017cb3c2eb2266368adf2797b4cc59d7832008ffa7fead969e1a05829c04edb4267792ee859d37ad74c991a0a79c05a53749b1a845820326cee6d9bce2e754f3cc1db7e18ac0ed861d8bf1b53a985d256cc8e34f45a1ba17fa96a2ded37dd7a7ef8f3dffd7bdf5f0dd866bede06d149fca009022626f335003406b55246010000",
+		"d9b3b5ec5d6e10737ed24ec0d694501a": "1f8b08000000000000ff003300ccff6b746c696e74207b0a097265706f7274657273207b0a09097265706f727465722027636865636b7374796c65270a097d0a7d0a0300f938d81033000000",
+		"dc68c11bd3df8176249f95a4da8b39b0": "1f8b08000000000000ff2a492cce2ed6cb4a4cce4fce0f492d2e094a2dc82f2a51a8e6525050502802738aa13c10aac8cdd1cb2c76cd4b4cca494d51b05528292a4d854b6694e092ade5aae5e2825855925a0c333e2d332f3127b32a35c5a95203bb3b34b96ab9000300d9c82e12a2000000",
+		"de159fbbe0f84923234cb0504c782ca6": "1f8b08000000000000ff001f00e0ff726f6f7450726f6a6563742e6e616d65203d20277b7b2e4e616d657d7d270a0300a81071bc1f000000",
+		"eab6828f99b7b7fa943e63bac1fe3bc8": "1f8b08000000000000ff9492c16ed4301086cff15358bd785722d6f6c84a1c4a590115a2ab06b8bbc9ac191a7b82ed6459597e771427445b5a907a1bcdfc33f3f91f776dafd17a1e59810d17e4b4f49d43ab0f4e1938927b90f74441f0019c47b25cc428abac784b14be4dd994c4d48f34b7cb063ab00dd8fa541a65950603f67ccca5dcc8d7f26ef7697755ede66eed88869388110f1c7e72b957f583d26835bf382a7791d2243b2a276204dba4c41263da51dff13799ecfd188f347ff6e4341eb85c48633c8fa1f590d2466ee465597dbeda571f6ebfccb305f3d4bb1aaec9742ae03db6184ef39e1b35a8ea6975dccc1c74e4319043c8be1a3580bd061b9c6a57eb9178f16652c45872a7ac062edf9d55249aaecdb6a990c95911a34c29eb33212b1e4ba6fbd5d4c077d57b39d9b95d5c7d6e87eb6d4003b7b63d2d0be6f7ffef0c9da3011b68eea6f67f7f9ced942cc7b8f441b900ae0c646a15961b16017cf8f8e825ab170e041fc47a34bb805f75db37c0f3afd84e603f7a8b410e6883d2205e71434ddfc2968b5c28e7420956a305c18af4bc574f31ffb66c3ceea81a497a0f375f2d867dabc2819c59ad5962bf070031eebbf46f030000",
+		"ebb8142a5d82d8b7320ba0b02953d342": "1f8b08000000000000ffcc555b6fdb36147ed7aff8a6810b30c04a330c7b30a0226aa3240d9cd8b01d37030a188c786cb3904881a46c1745f7db0752b26217d9c32e0fd3834452e7f29def5c78295788d955feeef186c54853c4312ea9d868e8d52aba3454e1c7ffec09f6c20bb8315c9404ebb8714d0d5b18593bacb4c147a984ded923e183febf7fa2601533722875c14bd842d714dcba0d61cb8de4cf2559eca4db60d722c1c31c7643651905b2c63316a769dcc15c3ecc635872c15c145972b8fa307dc8eef394fd21ea37ad4e77d4537c2c9604a56c3259becb66f9b253556ffae3dbb13f3998e842c88480a0156f4a87bbc53d74eda456161b3294e077dda0e00abcb41a8d25dc658b6c399ecc67e04ae0669a5d8df276ef346a6eed8911a7e136d2765969015ee5d7d9e368bebc5bdc07c5341e3c55fbdf7ead62f895f5ab36fef7a36c369b64f3db941de0b34feb90ef4f3bc3eb9a4cb71d74dbe4333791678af6d23ac4ac37c162acb5d3584925eef89647912fce24bc914fa7e3e91047d2901695b456aa75722c396d14ce5a9fe87c6230e8406cc958a915be7e4ddaaa5cb407dfbe9d41ab82e03484dea9527301e992a845c4651945c31e58c8cab554029ff99627b4271f90a09554245afe3d13a7e15c1b5df9efadaea8252f08e64f79da5b618723861eeadb87c7117e79fbd385f711b340c4285fe4a3aec2de74b4d19e8ac6d16bacbd2092164a3b5fc4a13894c699777e864257953f2974530a3cfb3e69948054f8a21b03cff809cb9392b8a560c877d38b87435ff5aaa4b6d2685591729edd8abb6203b7a1602db4a6af66e855ebc95304a9ace36519fe24d1ab4938a1b367d3d39eb27e398c53764af5cb3f76fe2cd5794ffd7149f659f89bd47a4f4e832b48b5e5a51410d250e1b4f932c491e7ff059187a04231e7eda61bd5d10905ecfb71c0ba58baf5d18461880757daac93b6dd125ed78a5794b29391c7620c8a925b5b73b7f96e021c291f06468be963bbbbe75281fdecf12bd16157e29f4ff8e3764ad3be992a2e55ae84af37dffb878ba4cf491773fef461be7c3f7e988d473964201e8a4804ffcb76a42e61c83546a1d0c227d33ae2027a158c06b9a212be04715e9cc8fee01186768ddb4e67af786531682f1d2ea2f0397fc645140d0ff0ffea122325ba4b6ca82b5af3e8cf01001bf2d4331c080000",
+		"ec6adf60b745f164bc4ecc8937e1a57b": "1f8b08000000000000ff9c565d4fe33a13becfafb0960bde576a53e95c463a175d96b380d082e8ee353b8da789c1f198b1d3d28df2df8f9ca4f9a212d2692211cf3cf6cc335fe64280b55aa5e01599f858e82852664749248418ab5a41780c149888aa8a7f408175ddcbb7e0f017eb46b541de233f32794a49d775b25af5d21b72beae930145eceb3a8ad0484bca78d79a7279e9251d4686d1c056a34c84e7127ba943e394577b4cc40eb46b153982f6f97fda1a5e8769c9bd34729695c9dad30a5023970eb8bd367bc5640a34be3b3eaa2ab513f826e2ebc6e3bbc775a3e862752182e07f7716be82c32b323b9595dcc47f216ed416d980c73b0bebd2d344fdff66ff8b85c10197d361e9def49c81040f21214d36ee2c7cebd6a37ce52753c3695d7a7bb2e3c7e647a752d04be7193c66c7441067717f4abc25f271411275dc9e113f765b7e34cb4db76fe3c14860795b583db122a55e42e9291186cc40253ca5c3a5c1c352c9658606193cf1b2006b95c9dc9cbb65b2c85e6157499f100eafc41d94da3fbb34c702ce55f7e91702fe3c0a7873d42ce1f7eaad5421fae3b4eb93f04c5556d57ce7c8b264b2ff28767ece33cd317dbdcac164784fd93d758d3aadf1f4a44f44aac1390b3e4fe4765580f3c8f17ba1a35910366763505568645d47a7bf9ab2acaf939dd29888d51e78a5295bf53b634d5903d0b8473d100f85d336d58ea1c003f16b7cc06d222eaf9f9e1e9e2e27c0216d1fd42915714694698c0bb02efe8eb4b6ea8a8cc777ff39fce1f5c67bfb846f253a7f03466ae46157e49af1d47a6d89fd78b2b533ab3bd4323a379991e7e74da10afc79b4e81271391aadab1747663116bc177ab27e813db89495f58bc06c95fb42b75fefa70fab419996aaa722053f38c35890c767659f730419287e795fee880fc01265f8fad2636d37b2cf431bed970f036e13c6a5f2c753b9bb6e9d44935854d50c5fd7e7bae7615dfafcaf71eb1004c94028d52a4cdb7e3dc86ee539e90653c6191ed2149dfb49af687eb19aea3c1a989f1f5ca081d4e9c7e8a8e47436524a871c5810ab3f4d463f987029594c0459344a4e343eb814da27e97c7c6e24134c4b2b5840e3bb2269da36dc59c445f4b97bb766471fbcb28c3be42628413f1e38f3fe9fd7c0fa4fc9b84ec345fc4d31a69eb8af870bf1f5789a2e0b01011943039527688cc3357a1ae0479183137bd0258adf99a62de8df8be842b8d2867644d9e99413ad7621521347179f5bf8bbc5470db0fb57678a1ee2d2c67aa9e407919b95d58cfd77a6d28eaea0369bcb269bcb92551255151a59d7ff0e00f6592cce83090000",
+		"f8696b8b5592e53679b678fc62e959fa": "1f8b08000000000000ffdc566f6fe2b8137ecfa71821b4e9564d22fd5e22f15b51887ad52e6d0574ef2532c9107c384ece766891cfdffd64132081f4cfea746f2eb1d4da33e33cf33c83c75a27b8a21ca14b52e4aa6b8cd6740581311a185922034f6b41788ad0a33cc1d71be82992427fe07cacef7edd18f8f205b4469ed875eb64ffbaa907f63f26d118c277471fe409f8c6744e18962565098a1a8a0ed41e07117463cd8e248f37285a0c76d08ca468b3088cf15a3d0496121ff2044189122f5c4c63c55cc02f6881cc52a83b35905a615630a28ecc42f0543906d392731473924a633a2e08f9968a9c67cdfcc68fa3efd174318d9e1e61e05218bb4c47395fd13498624aa512bb67c18cf1b406ba02fc13825bcbe23ccf1974534112867e21f23f30b6ea1ef7be9b0ec73fa2c5f32c9a2e7e7b9c4430806e4ffffe38fd3e7b1a8e221306fbd8ee41b963e464f8337a583c3ecd6736c61f67648b3c1058e401cb63c206cd6db2ff85d626a9cac5ae5bb1e736ab72978aa4286b698721b814a0827d3438cf2bcf5589f715b4eeea63a98c729e5045735e436ac7cb1a3968d0baee5153f124d3a1f86a42391828ee6d099ded2b1516f204e197b83fbc720d5e10ee797e819821e1e05080ff0a0aa502df77733f26f11ac1f70b220863c8bc0b550eef7ecf6ccb5fc0bfadf62c48bcb13f027f2c37b498a354d2ab0b71789bb32297f5623c3cb28c6394b2c5625f22e235dde25028ba22b192400efff5e19325ea320e195dcaf0fafa90a7222245155e07df88a8a07b37b0a23c455108ca55ff533fdee3cc74eae516bd625c2a84d81e0252ed1842bcc678035fc0b1755e81cef8dfab40ad833ba7c6c8e6372772238df9c735b8454157bb0f0ace76812a81889325c359ce89709a1ad326d5deee843857475a9355a74d06c731e5e99ca4575fe123fadd576631e1fc5d0d1a6b76c444c5eb48885c5cb95da7284ba6fae0cd9e47a36836f36ef6608febcf0fb3f9f0f6477401fbf05aa55c62bedcc3017fece64125ef4f1492e67cd0d3f3e1dde26138895ada9de9b4cf4ce75c9e30849140dbbff63da7eaa34b2231819c834086442228929ef36f8f9b4fd2df70696f8287e77e32bc8b5c5eb6ed687d12c909333df61863fa350eba6f64fc9e7a96ebea4e511dc70a7ab5ef07de7b214529d776a91ef26e80c828bc1f70826d3ac7eb589daefea056b4d1c9209b8a8eb160f9cecaa7753d3e782059bdb42b2113e7eeb7fabe217063c98e25ae7281f7bc2855fb09dda88886b5f5f27779af6a80ab5faddc89d2b04e082f091b1685c8b784d512b6833a8c97d590a194b67b7a157b5af72a0ede24f2db997c6d675f2daa85b65325ecabbdf72f977bb649a800bf804db944c151a17c43f7d6f098a873262684d3154af544d4da18f86b7fb3591c6ecb619607720dfffff083a175f0e3fd957797b17600d629560c7ceba9f0f502ce889552a170fd8c930c6541621cb47cd1198c0152146c07feea171969395301004cc774fe1e002e6114576c0d0000",
+		"fab359901a0fce860099cdeb261b7a21": "1f8b08000000000000ff548cb1aec2300c00e7f82bbca55d22bde18d9d901899107b485d1ae1c695634411cabfa30e0c6ca793ee928c54a2267c833311be90d62c0507f47fe1df834b52a67c3b66261c70ca4c9dff36615bd8f7d0002cd67b0dcf6cf3f9b5527790914e5153bf5f955651ab3bba6de140255e99461cd0f441e0dc6c3f768a5c095c83069f01001c247ad49d000000",
 	})
 	if err != nil {
 		panic(err)
@@ -38,23 +75,60 @@ var _ = func() error {
 
 	func() {
 		b := packr.New("java-spring", "../templates")
-		b.SetResolver("Dockerfile.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "0dc0260be2c4c19a4b86c24c6c934354"})
-		b.SetResolver("build.gradle.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "70b8a59a53016b8dfd6a3ec6bd37a40b"})
-		b.SetResolver("buildpipeline/.gitignore.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "ed19bc9d1eecac29400a2b85bce057e6"})
-		b.SetResolver("buildpipeline/.gitlab-ci-default.yml", packr.Pointer{ForwardBox: gk, ForwardPath: "d56175353bc8de00d12c9ac2f18cdb17"})
-		b.SetResolver("buildpipeline/mo.sh", packr.Pointer{ForwardBox: gk, ForwardPath: "8df9751018ac4c5b2a6f924e189cedbc"})
-		b.SetResolver("config/application-int.yml.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "031812bd14f8971d94c3d49bb067a3b6"})
-		b.SetResolver("config/application-local.yml.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "4fcc82ee9a174ee93414e8aa73b83a53"})
-		b.SetResolver("config/application-prod.yml.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "339a0c66d644f38aff3f946bce4e0ca6"})
-		b.SetResolver("config/application.yml.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "aaf5c1dca6dcb7fb692bb04101b766c8"})
-		b.SetResolver("config/liquibase-master.xml.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "a457dc800d75dfc09217e62dc409d256"})
-		b.SetResolver("kubernetes/prod/kube-config.yml", packr.Pointer{ForwardBox: gk, ForwardPath: "b349944b2e8081835cc6af954c90cfb8"})
-		b.SetResolver("kubernetes/stg/kube-config.yml", packr.Pointer{ForwardBox: gk, ForwardPath: "c0c5ff425efeb1c7fcc23cd029fe9a4d"})
-		b.SetResolver("spring/kotlin/build.gradle.kts", packr.Pointer{ForwardBox: gk, ForwardPath: "fb803e371806c197257fb5ae51d77872"})
-		b.SetResolver("spring/kotlin/settings.gradle.kts", packr.Pointer{ForwardBox: gk, ForwardPath: "d5c79e86ea8df54a6153e4b698b330e6"})
-		b.SetResolver("spring/sonar-project.properties", packr.Pointer{ForwardBox: gk, ForwardPath: "f08bfb9f66faa388105b1cebb7e8b7ad"})
-		b.SetResolver("spring.initializr.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "2ebfbecccbc9fd5a9c704321d2197ec8"})
-	}()
+		b.SetResolver("Dockerfile.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "d06c60a87d13ef07c711e662e82f3f6d"})
+		b.SetResolver("buildpipeline/.gitignore.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "38078b56844b47d94c610bf7ad5d6918"})
+		b.SetResolver("buildpipeline/.gitlab-ci-default.yml", packr.Pointer{ForwardBox: gk, ForwardPath: "0b2a938e7e960efa8ea8c8f28bef0e4f"})
+		b.SetResolver("buildpipeline/Jenkinsfile.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "f8696b8b5592e53679b678fc62e959fa"})
+		b.SetResolver("buildpipeline/github-actions.yml", packr.Pointer{ForwardBox: gk, ForwardPath: "70a44a706c40329eb61d7e8acc4702b8"})
+		b.SetResolver("buildpipeline/mo.sh", packr.Pointer{ForwardBox: gk, ForwardPath: "baae059ba6eaf3a9239ea8fb0ae23fbe"})
+		b.SetResolver("buildpipeline/tekton/pipeline.yml.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "b1ea272be75e0d3124b02629a203dee2"})
+		b.SetResolver("buildpipeline/tekton/tasks.yml.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "7422accb3faca67aac698ef7437a3d3b"})
+		b.SetResolver("config/application-int.yml.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "a04192e2312fdfbf97f2602bc5c2f61f"})
+		b.SetResolver("config/application-local.yml.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "c137a9aa92bcf0fc6415d80c9e8016c6"})
+		b.SetResolver("config/application-prod.yml.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "4fe9ba136da998b7adb875763035b2ae"})
+		b.SetResolver("config/application.yml.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "ec6adf60b745f164bc4ecc8937e1a57b"})
+		b.SetResolver("config/liquibase-master.xml.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "72454e64a95435640ecb95fca6ea09a4"})
+		b.SetResolver("gitlab/merge-request.md.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "1657259822040c71806f2179d55703c6"})
+		b.SetResolver("kubernetes/prod/kube-config.yml", packr.Pointer{ForwardBox: gk, ForwardPath: "b286bb0885ce57a4fc2844689585e700"})
+		b.SetResolver("kubernetes/stg/kube-config.yml", packr.Pointer{ForwardBox: gk, ForwardPath: "1bca5c6fd6605d933bf0c7d967977762"})
+		b.SetResolver("server/index.html", packr.Pointer{ForwardBox: gk, ForwardPath: "62d4f40c27cb7b714511ac408a8e3e32"})
+		b.SetResolver("spring/features/codenarc.gradle.kts.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "0de43e66867f973b678d25d7984a3276"})
+		b.SetResolver("spring/features/codenarc.gradle.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "fab359901a0fce860099cdeb261b7a21"})
+		b.SetResolver("spring/features/detekt.gradle.kts.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "1551d8c7e7c29840c465293906ecf514"})
+		b.SetResolver("spring/features/detekt.gradle.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "be96937edfa4d2e97c002fcb080f030a"})
+		b.SetResolver("spring/features/jacoco.gradle.kts.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "dc68c11bd3df8176249f95a4da8b39b0"})
+		b.SetResolver("spring/features/jacoco.gradle.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "990b823750185434696cea251c61f62f"})
+		b.SetResolver("spring/features/jib.gradle.kts.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "697f4f0bcca6468461ca6a76969d7e31"})
+		b.SetResolver("spring/features/jib.gradle.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "7da2f60e29b0d15d8998a70242353a2d"})
+		b.SetResolver("spring/features/ktlint.gradle.kts.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "64edc3431c80e867812bd8fbafaef48b"})
+		b.SetResolver("spring/features/ktlint.gradle.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "d9b3b5ec5d6e10737ed24ec0d694501a"})
+		b.SetResolver("spring/features/sonarqube.gradle.kts.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "1e0b5f439747defa96cd31fd38a37345"})
+		b.SetResolver("spring/features/sonarqube.gradle.tmpl", packr.Pointer{ForwardBox: gk, ForwardPath: "72d35672dbcffbb34a49978456cba19a"})
+		b.SetResolver("spring/lint/groovy/codenarc.xml", packr.Pointer{ForwardBox: gk, ForwardPath: "48d8169becc3d8e72361bc784cfe43cd"})
+		b.SetResolver("spring/lint/kotlin/detekt.yml", packr.Pointer{ForwardBox: gk, ForwardPath: "d3f75a44f29b50d0955a34b66cd5f4d8"})
+		b.SetResolver("spring/lint/kotlin/editorconfig", packr.Pointer{ForwardBox: gk, ForwardPath: "6081bb9c5323d7786c745ba197d8de34"})
+		b.SetResolver("spring/skeleton/HELP.md", packr.Pointer{ForwardBox: gk, ForwardPath: "a9f5d24020a76cb0aab4014cf0c26af1"})
+		b.SetResolver("spring/skeleton/application.properties", packr.Pointer{ForwardBox: gk, ForwardPath: "97645b54df14a25f32ff39786504a8fd"})
+		b.SetResolver("spring/skeleton/gradle/gradle-wrapper.properties", packr.Pointer{ForwardBox: gk, ForwardPath: "25dbb07c80e3c1f6ba1a068d864e18be"})
+		b.SetResolver("spring/skeleton/gradle/gradlew", packr.Pointer{ForwardBox: gk, ForwardPath: "d37e805da199b44bf58ba3d8b3f7c418"})
+		b.SetResolver("spring/skeleton/gradle/gradlew.bat", packr.Pointer{ForwardBox: gk, ForwardPath: "ebb8142a5d82d8b7320ba0b02953d342"})
+		b.SetResolver("spring/skeleton/groovy/Application.groovy", packr.Pointer{ForwardBox: gk, ForwardPath: "4776a8d6fc6d9ed84770f4dbb123faa2"})
+		b.SetResolver("spring/skeleton/groovy/ApplicationTests.groovy", packr.Pointer{ForwardBox: gk, ForwardPath: "c757e3eb5e5b91793cb41d6b01f0fd4f"})
+		b.SetResolver("spring/skeleton/groovy/ServletInitializer.groovy", packr.Pointer{ForwardBox: gk, ForwardPath: "12a7caf441d54ab5af40b373c494e963"})
+		b.SetResolver("spring/skeleton/groovy/build.gradle", packr.Pointer{ForwardBox: gk, ForwardPath: "eab6828f99b7b7fa943e63bac1fe3bc8"})
+		b.SetResolver("spring/skeleton/groovy/settings.gradle", packr.Pointer{ForwardBox: gk, ForwardPath: "5ef075cb22365d9499df5d53e229e0a9"})
+		b.SetResolver("spring/skeleton/java/Application.java", packr.Pointer{ForwardBox: gk, ForwardPath: "a474a970f83d5b3cc7eb863b7a684636"})
+		b.SetResolver("spring/skeleton/java/ApplicationTests.java", packr.Pointer{ForwardBox: gk, ForwardPath: "d1366725ed088ac91de68a598d4c46ab"})
+		b.SetResolver("spring/skeleton/java/ServletInitializer.java", packr.Pointer{ForwardBox: gk, ForwardPath: "c350506443014b0c5843f5244ae6d73e"})
+		b.SetResolver("spring/skeleton/java/build.gradle", packr.Pointer{ForwardBox: gk, ForwardPath: "2cfa36febe503e9f5da69eb98006af86"})
+		b.SetResolver("spring/skeleton/java/settings.gradle", packr.Pointer{ForwardBox: gk, ForwardPath: "de159fbbe0f84923234cb0504c782ca6"})
+		b.SetResolver("spring/skeleton/kotlin/Application.kt", packr.Pointer{ForwardBox: gk, ForwardPath: "3d16d2ae818e792a5e0caf6e08ec598c"})
+		b.SetResolver("spring/skeleton/kotlin/ApplicationTests.kt", packr.Pointer{ForwardBox: gk, ForwardPath: "b3bb4420f8c3bafd8bbd81dbc2635313"})
+		b.SetResolver("spring/skeleton/kotlin/ServletInitializer.kt", packr.Pointer{ForwardBox: gk, ForwardPath: "cbbd434cbcd44d4f84aceff85c49e381"})
+		b.SetResolver("spring/skeleton/kotlin/build.gradle.kts", packr.Pointer{ForwardBox: gk, ForwardPath: "bf3ae73a3da158b833593192d56b59ed"})
+		b.SetResolver("spring/skeleton/kotlin/settings.gradle.kts", packr.Pointer{ForwardBox: gk, ForwardPath: "82eae162996d86946dec74437b88776f"})
+		b.SetResolver("spring/sonar-project.properties", packr.Pointer{ForwardBox: gk, ForwardPath: "71877dcaf5dbdd618969be35f6442d47"})
+		}()
 
 	return nil
 }()
@@ -0,0 +1,241 @@
+package rlctl
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"github.com/rocketlaunchercloud/rlctl/project/gitlab"
+	"github.com/rocketlaunchercloud/rlctl/project/spring"
+	"gopkg.in/yaml.v2"
+	"sort"
+	"strings"
+)
+
+const DefaultAuditConcurrency = 8
+
+// AuditStatus summarises how far a project is behind the current templates.
+type AuditStatus string
+
+const (
+	// AuditCurrent projects use the current template version and their managed files match the templates.
+	AuditCurrent AuditStatus = "current"
+	// AuditDiverged projects use the current template version, but managed files were changed.
+	AuditDiverged AuditStatus = "diverged"
+	// AuditOutdated projects were generated with an older template version.
+	AuditOutdated AuditStatus = "outdated"
+	// AuditUnmanaged projects have no stored manifest, they were not generated by rlctl or by a version before
+	// manifests were stored.
+	AuditUnmanaged AuditStatus = "unmanaged"
+	AuditFailed    AuditStatus = "error"
+)
+
+// auditedFiles are checked in projects without stored manifest.
+var auditedFiles = []string{".gitlab-ci.yml", "Dockerfile", spring.K8SStagingTemplate, spring.K8SProdTemplate}
+
+// deprecatedApiVersions were removed from Kubernetes, the generated Deployments used apps/v1beta1 until template
+// version 2.
+var deprecatedApiVersions = map[string]bool{"apps/v1beta1": true, "apps/v1beta2": true, "extensions/v1beta1": true}
+
+// PolicyCheck checks the files of a project, manifest is nil for unmanaged projects. It returns a message for every
+// violation.
+type PolicyCheck struct {
+	Name  string
+	Check func(files map[string][]byte, manifest *Manifest) []string
+}
+
+// PolicyChecks are run by Audit on every project.
+var PolicyChecks = []PolicyCheck{
+	{Name: "kubernetes-api-version", Check: checkKubernetesApiVersions},
+	{Name: "gitlab-ci-lint", Check: checkGitlabCI},
+}
+
+// AuditOptions control an Audit call.
+type AuditOptions struct {
+	// Concurrency is the number of projects audited at the same time, it defaults to DefaultAuditConcurrency.
+	Concurrency int
+	// Preset, if set, adds a check of the settings it locks.
+	Preset *spring.Preset
+	// Done is notified after every project, it may be nil. It is called by the workers and must be safe for
+	// concurrent use.
+	Done func(AuditResult)
+}
+
+// AuditResult describes a project. Diverged lists the managed files which differ from the current templates,
+// FailedChecks the violations prefixed with the name of the check.
+type AuditResult struct {
+	Project         string      `json:"project"`
+	WebUrl          string      `json:"web_url"`
+	Status          AuditStatus `json:"status"`
+	TemplateVersion int         `json:"template_version"`
+	Diverged        []string    `json:"diverged"`
+	FailedChecks    []string    `json:"failed_checks"`
+	Error           string      `json:"error,omitempty"`
+}
+
+// Audit reads the stored manifest of every project of the group and its subgroups and compares the projects with the
+// current templates. The results are sorted by project.
+func Audit(ctx context.Context, client *gitlab.Client, group string, options AuditOptions) ([]AuditResult, error) {
+	projects, err := client.GroupProjects(ctx, group)
+	if err != nil {
+		return nil, err
+	}
+	concurrency := options.Concurrency
+	if concurrency <= 0 {
+		concurrency = DefaultAuditConcurrency
+	}
+
+	results := make([]AuditResult, len(projects))
+	forEach(len(projects), concurrency, func(i int) {
+		results[i] = AuditProject(ctx, client, projects[i], options.Preset)
+		if options.Done != nil {
+			options.Done(results[i])
+		}
+	})
+	sort.Slice(results, func(i, j int) bool {
+		return results[i].Project < results[j].Project
+	})
+	return results, nil
+}
+
+// AuditProject audits the default branch of the project, preset may be nil.
+func AuditProject(ctx context.Context, client *gitlab.Client, project gitlab.GitlabProject, preset *spring.Preset) AuditResult {
+	result := AuditResult{Project: project.PathWithNamespace, WebUrl: project.WebUrl, Diverged: []string{}, FailedChecks: []string{}}
+	fail := func(err error) AuditResult {
+		result.Status, result.Error = AuditFailed, err.Error()
+		return result
+	}
+	if project.DefaultBranch == "" {
+		// Empty repository
+		result.Status = AuditUnmanaged
+		return result
+	}
+
+	read := func(file string) ([]byte, error) {
+		return client.RawFile(ctx, project.PathWithNamespace, file, project.DefaultBranch)
+	}
+	data, err := read(spring.ManifestFile)
+	if err != nil {
+		return fail(err)
+	}
+
+	var manifest *Manifest
+	paths := auditedFiles
+	var expected map[string][]byte
+	if data != nil {
+		manifest = &Manifest{Spring: spring.DefaultSpringProjectConfig()}
+		// Not strict, settings of former versions must not stop the audit.
+		if err = yaml.Unmarshal(data, manifest); err != nil {
+			return fail(err)
+		}
+		result.TemplateVersion = manifest.TemplateVersion
+		if expected, err = spring.ManagedFiles(manifest.Spring); err != nil {
+			return fail(err)
+		}
+		paths = make([]string, 0, len(expected))
+		for file := range expected {
+			paths = append(paths, file)
+		}
+		sort.Strings(paths)
+	}
+
+	files := map[string][]byte{}
+	for _, file := range paths {
+		content, err := read(file)
+		if err != nil {
+			return fail(err)
+		}
+		if content != nil {
+			files[file] = content
+		}
+	}
+
+	for _, file := range paths {
+		if manifest == nil {
+			break
+		}
+		if content, found := files[file]; !found {
+			result.Diverged = append(result.Diverged, file+" (missing)")
+		} else if !bytes.Equal(normalize(content), normalize(expected[file])) {
+			result.Diverged = append(result.Diverged, file)
+		}
+	}
+
+	for _, check := range PolicyChecks {
+		for _, message := range check.Check(files, manifest) {
+			result.FailedChecks = append(result.FailedChecks, check.Name+": "+message)
+		}
+	}
+	if preset != nil && manifest != nil {
+		violations, err := preset.Violations(manifest.Spring)
+		if err != nil {
+			return fail(err)
+		}
+		for _, violation := range violations {
+			result.FailedChecks = append(result.FailedChecks, "preset: "+violation)
+		}
+	}
+
+	switch {
+	case manifest == nil:
+		result.Status = AuditUnmanaged
+	case manifest.TemplateVersion < spring.RequiredTemplateVersion(manifest.Spring):
+		result.Status = AuditOutdated
+	case len(result.Diverged) > 0:
+		result.Status = AuditDiverged
+	default:
+		result.Status = AuditCurrent
+	}
+	return result
+}
+
+// normalize ignores line endings and trailing white space.
+func normalize(content []byte) []byte {
+	return bytes.TrimSpace(bytes.ReplaceAll(content, []byte("\r\n"), []byte("\n")))
+}
+
+func checkKubernetesApiVersions(files map[string][]byte, manifest *Manifest) []string {
+	var messages []string
+	for _, file := range sortedKeys(files) {
+		if !strings.HasPrefix(file, "kubernetes/") {
+			continue
+		}
+		scanner := bufio.NewScanner(bytes.NewReader(files[file]))
+		for scanner.Scan() {
+			line := strings.TrimSpace(scanner.Text())
+			if !strings.HasPrefix(line, "apiVersion:") {
+				continue
+			}
+			if version := strings.TrimSpace(strings.TrimPrefix(line, "apiVersion:")); deprecatedApiVersions[version] {
+				messages = append(messages, file+" uses "+version)
+			}
+		}
+	}
+	return messages
+}
+
+func checkGitlabCI(files map[string][]byte, manifest *Manifest) []string {
+	content, found := files[".gitlab-ci.yml"]
+	if !found {
+		return nil
+	}
+	issues, err := gitlab.LintCIConfig(content)
+	if err != nil {
+		return []string{".gitlab-ci.yml is invalid: " + err.Error()}
+	}
+	var messages []string
+	for _, issue := range issues {
+		if issue.Severity == gitlab.LintError {
+			messages = append(messages, issue.Job+": "+issue.Message)
+		}
+	}
+	return messages
+}
+
+func sortedKeys(files map[string][]byte) []string {
+	keys := make([]string, 0, len(files))
+	for key := range files {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,104 @@
+package rlctl_test
+
+import (
+	"bytes"
+	"context"
+	"github.com/rocketlaunchercloud/rlctl/pkg/rlctl"
+	"github.com/rocketlaunchercloud/rlctl/project/spring"
+	"gopkg.in/yaml.v2"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+func TestAudit(t *testing.T) {
+	files := map[string][]byte{}
+	addProject := func(project, language string, version int, changes map[string][]byte) {
+		config := spring.DefaultSpringProjectConfig()
+		config.Name, config.Group, config.Language = project, "com.example", language
+		managed, err := spring.ManagedFiles(config)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if version > 0 {
+			if managed[spring.ManifestFile], err = yaml.Marshal(spring.StoredManifest{TemplateVersion: version, Spring: config}); err != nil {
+				t.Fatal(err)
+			}
+		}
+		for file, content := range changes {
+			managed[file] = content
+		}
+		for file, content := range managed {
+			if content != nil {
+				files["/projects/"+url.PathEscape("shop/"+project)+"/repository/files/"+url.PathEscape(file)+"/raw"] = content
+			}
+		}
+	}
+	addProject("orders", spring.Java, spring.TemplateVersion, nil)
+	addProject("payments", spring.Java, spring.TemplateVersion, map[string][]byte{"Dockerfile": []byte("FROM scratch\n"), spring.K8SStagingTemplate: nil})
+	defaults, _ := spring.ManagedFiles(spring.DefaultSpringProjectConfig())
+	addProject("invoices", spring.Java, 1, map[string][]byte{spring.K8SProdTemplate: bytes.Replace(defaults[spring.K8SStagingTemplate], []byte("apps/v1"), []byte("apps/v1beta1"), 1)})
+	addProject("legacy", spring.Java, 0, nil)
+	// Version 3 changed the Kotlin templates only
+	addProject("billing", spring.Java, 2, nil)
+	addProject("shipping", spring.Kotlin, 2, nil)
+	addProject("tracking", spring.Kotlin, 3, nil)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.EscapedPath() == "/groups/shop/projects" {
+			w.Write([]byte(`[
+{"path_with_namespace":"shop/payments","default_branch":"main"},
+{"path_with_namespace":"shop/orders","default_branch":"main","web_url":"https://gitlab.example.com/shop/orders"},
+{"path_with_namespace":"shop/invoices","default_branch":"main"},
+{"path_with_namespace":"shop/legacy","default_branch":"main"},
+{"path_with_namespace":"shop/billing","default_branch":"main"},
+{"path_with_namespace":"shop/shipping","default_branch":"main"},
+{"path_with_namespace":"shop/tracking","default_branch":"main"},
+{"path_with_namespace":"shop/empty"}]`))
+			return
+		}
+		if content, found := files[r.URL.EscapedPath()]; found && r.URL.Query().Get("ref") == "main" {
+			w.Write(content)
+			return
+		}
+		http.NotFound(w, r)
+	}))
+	defer server.Close()
+
+	client := rlctl.NewGitlabClient("secret")
+	client.BaseUrl = server.URL
+	results, err := rlctl.Audit(context.Background(), client, "shop", rlctl.AuditOptions{Concurrency: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []struct {
+		project  string
+		status   rlctl.AuditStatus
+		diverged int
+		checks   int
+	}{
+		{"shop/billing", rlctl.AuditCurrent, 0, 0},
+		{"shop/empty", rlctl.AuditUnmanaged, 0, 0},
+		{"shop/invoices", rlctl.AuditOutdated, 1, 1},
+		{"shop/legacy", rlctl.AuditUnmanaged, 0, 0},
+		{"shop/orders", rlctl.AuditCurrent, 0, 0},
+		{"shop/payments", rlctl.AuditDiverged, 2, 0},
+		{"shop/shipping", rlctl.AuditOutdated, 0, 0},
+		{"shop/tracking", rlctl.AuditCurrent, 0, 0},
+	}
+	if len(results) != len(expected) {
+		t.Fatalf("unexpected results %+v", results)
+	}
+	for i, want := range expected {
+		result := results[i]
+		if result.Project != want.project || result.Status != want.status || len(result.Diverged) != want.diverged ||
+			len(result.FailedChecks) != want.checks || result.Error != "" {
+			t.Errorf("expected %+v, got %+v", want, result)
+		}
+	}
+	if results[2].TemplateVersion != 1 || results[2].FailedChecks[0] != "kubernetes-api-version: "+spring.K8SProdTemplate+" uses apps/v1beta1" {
+		t.Errorf("unexpected invoices result %+v", results[2])
+	}
+}
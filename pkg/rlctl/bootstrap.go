@@ -0,0 +1,329 @@
+package rlctl
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"github.com/rocketlaunchercloud/rlctl/project/gitlab"
+	"github.com/rocketlaunchercloud/rlctl/project/spring"
+	"github.com/rocketlaunchercloud/rlctl/util"
+	"gopkg.in/yaml.v2"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// Bootstrap steps besides the generation steps of spring.
+	CheckStep   = "check"
+	PublishStep = "publish"
+
+	DefaultBootstrapConcurrency = 4
+)
+
+// BootstrapStatus is the outcome of bootstrapping a service.
+type BootstrapStatus string
+
+const (
+	BootstrapCreated BootstrapStatus = "created"
+	BootstrapSkipped BootstrapStatus = "skipped"
+	BootstrapFailed  BootstrapStatus = "failed"
+)
+
+// Sections are manifest settings by section and flag name, e.g. sections["gitlab"]["namespace_id"].
+type Sections map[string]map[string]interface{}
+
+// Inventory lists the services of a bulk bootstrap, every service is a manifest with a gitlab section.
+type Inventory struct {
+	Services []Manifest
+}
+
+type inventoryFile struct {
+	Defaults Sections   `yaml:"defaults"`
+	Services []Sections `yaml:"services"`
+}
+
+// BootstrapOptions control a Bootstrap call.
+type BootstrapOptions struct {
+	// OutputDirectory is the directory the services without output directory are generated into, each into a
+	// directory named after the service. It defaults to build.
+	OutputDirectory string
+	// Policy is the generation policy, see Options.
+	Policy string
+	// Concurrency is the number of services bootstrapped at the same time, it defaults to DefaultBootstrapConcurrency.
+	Concurrency int
+	// Preset, if set, rejects services which change a setting locked by the preset.
+	Preset *spring.Preset
+	// Progress is notified before every step and Done after every service, both may be nil. They are called by the
+	// workers and must be safe for concurrent use.
+	Progress func(BootstrapEvent)
+	Done     func(BootstrapResult)
+}
+
+// BootstrapEvent reports the step of the service which is about to start.
+type BootstrapEvent struct {
+	Service string
+	Event
+}
+
+// BootstrapResult is the outcome of a service, Project is the created or, for skipped services, the existing project.
+type BootstrapResult struct {
+	Service     string
+	Status      BootstrapStatus
+	Project     *gitlab.GitlabProject
+	DeployToken *gitlab.GitlabDeployToken
+	Err         error
+}
+
+// LoadInventory reads a YAML inventory or, if the file ends with .csv, a CSV inventory. defaults, e.g. the settings of
+// the config files, are overridden by the defaults of the inventory, which are overridden by the settings of the
+// services.
+func LoadInventory(path string, defaults Sections) (*Inventory, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, util.NewFileSystemError(path, err)
+	}
+	if strings.EqualFold(filepath.Ext(path), ".csv") {
+		return ParseInventoryCSV(path, data, defaults)
+	}
+	return ParseInventory(path, data, defaults)
+}
+
+// ParseInventory parses a YAML inventory with optional defaults and a list of services:
+//
+//	defaults:
+//	  spring: {group: com.example}
+//	  gitlab: {namespace_id: 42}
+//	services:
+//	  - spring: {name: orders}
+//	  - spring: {name: payments, language: kotlin}
+func ParseInventory(source string, data []byte, defaults Sections) (*Inventory, error) {
+	file := inventoryFile{}
+	if err := yaml.UnmarshalStrict(data, &file); err != nil {
+		return nil, util.NewValidationError("invalid inventory %s: %v", source, err)
+	}
+	return newInventory(source, file.Services, defaults, file.Defaults)
+}
+
+// ParseInventoryCSV parses a CSV inventory. The header names the settings, e.g. spring.name,gitlab.namespace_id,
+// every row is a service. Cells are YAML values, lists are written as [a, b], empty cells keep the defaults.
+func ParseInventoryCSV(source string, data []byte, defaults Sections) (*Inventory, error) {
+	records, err := csv.NewReader(bytes.NewReader(data)).ReadAll()
+	if err != nil {
+		return nil, util.NewValidationError("invalid inventory %s: %v", source, err)
+	}
+	if len(records) == 0 {
+		return nil, util.NewValidationError("invalid inventory %s: the header is missing", source)
+	}
+
+	header := records[0]
+	for _, key := range header {
+		if parts := strings.SplitN(strings.TrimSpace(key), ".", 2); len(parts) != 2 || parts[1] == "" {
+			return nil, util.NewValidationError("invalid inventory %s: column %q is not section.setting", source, key)
+		}
+	}
+	var services []Sections
+	for row, record := range records[1:] {
+		service := Sections{}
+		for column, cell := range record {
+			if cell = strings.TrimSpace(cell); cell == "" {
+				continue
+			}
+			var value interface{}
+			if err = yaml.Unmarshal([]byte(cell), &value); err != nil {
+				return nil, util.NewValidationError("invalid inventory %s: row %d, column %s: %v", source, row+2, header[column], err)
+			}
+			parts := strings.SplitN(strings.TrimSpace(header[column]), ".", 2)
+			if service[parts[0]] == nil {
+				service[parts[0]] = map[string]interface{}{}
+			}
+			service[parts[0]][parts[1]] = value
+		}
+		services = append(services, service)
+	}
+	return newInventory(source, services, defaults)
+}
+
+// newInventory merges every service over the layers of defaults and validates the manifests.
+func newInventory(source string, services []Sections, layers ...Sections) (*Inventory, error) {
+	if len(services) == 0 {
+		return nil, util.NewValidationError("invalid inventory %s: it has no services", source)
+	}
+	inventory := &Inventory{}
+	names := map[string]bool{}
+	for i, service := range services {
+		merged := Sections{}
+		for _, layer := range append(layers, service) {
+			for section, values := range layer {
+				if merged[section] == nil {
+					merged[section] = map[string]interface{}{}
+				}
+				for key, value := range values {
+					merged[section][key] = value
+				}
+			}
+		}
+
+		manifest, err := sectionsManifest(merged)
+		if err == nil {
+			err = validateService(manifest)
+		}
+		if err != nil {
+			return nil, util.NewValidationError("invalid inventory %s: service %d: %v", source, i+1, err)
+		}
+		if names[manifest.Spring.Name] {
+			return nil, util.NewValidationError("invalid inventory %s: service %s is listed twice", source, manifest.Spring.Name)
+		}
+		names[manifest.Spring.Name] = true
+		inventory.Services = append(inventory.Services, manifest)
+	}
+	return inventory, nil
+}
+
+// sectionsManifest decodes the sections like LoadManifest, missing spring settings keep their defaults.
+func sectionsManifest(sections Sections) (Manifest, error) {
+	manifest := Manifest{Spring: spring.DefaultSpringProjectConfig()}
+	data, err := yaml.Marshal(sections)
+	if err != nil {
+		return manifest, err
+	}
+	if err = yaml.UnmarshalStrict(data, &manifest); err != nil {
+		return manifest, err
+	}
+	if manifest.Gitlab != nil && manifest.Gitlab.Name == "" {
+		manifest.Gitlab.Name = manifest.Spring.Name
+	}
+	return manifest, nil
+}
+
+func validateService(manifest Manifest) error {
+	if err := util.ValidateRequired(manifest.Spring.Name, "spring.name"); err != nil {
+		return err
+	}
+	if err := util.ValidateRequired(manifest.Spring.Group, "spring.group"); err != nil {
+		return err
+	}
+	if manifest.Gitlab == nil || manifest.Gitlab.NamespaceID == 0 {
+		return util.NewValidationError("gitlab.namespace_id is mandatory!")
+	}
+	return manifest.Gitlab.ProvisioningConfig.Validate()
+}
+
+// Bootstrap generates and publishes the services of the inventory concurrently. Services whose GitLab project holds
+// the ManifestFile are skipped, services whose project was created by a failed bootstrap are pushed and provisioned,
+// so a failed bootstrap can be run again. The results are in the order of the inventory.
+func Bootstrap(ctx context.Context, client *gitlab.Client, inventory Inventory, options BootstrapOptions) []BootstrapResult {
+	concurrency := options.Concurrency
+	if concurrency <= 0 {
+		concurrency = DefaultBootstrapConcurrency
+	}
+	results := make([]BootstrapResult, len(inventory.Services))
+	forEach(len(results), concurrency, func(i int) {
+		results[i] = bootstrapService(ctx, client, inventory.Services[i], options)
+		if options.Done != nil {
+			options.Done(results[i])
+		}
+	})
+	return results
+}
+
+// forEach calls fn for 0 to count-1 with at most concurrency calls at the same time and returns when all returned.
+func forEach(count, concurrency int, fn func(i int)) {
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for worker := 0; worker < concurrency; worker++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				fn(i)
+			}
+		}()
+	}
+	for i := 0; i < count; i++ {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+}
+
+func bootstrapService(ctx context.Context, client *gitlab.Client, manifest Manifest, options BootstrapOptions) BootstrapResult {
+	result := BootstrapResult{Service: manifest.Spring.Name}
+	progress := func(step string) {
+		if options.Progress != nil {
+			options.Progress(BootstrapEvent{Service: result.Service, Event: Event{Step: step, Time: time.Now()}})
+		}
+	}
+	fail := func(err error) BootstrapResult {
+		result.Status, result.Err = BootstrapFailed, err
+		return result
+	}
+	if err := ctx.Err(); err != nil {
+		return fail(err)
+	}
+
+	progress(CheckStep)
+	project, err := client.FindProject(ctx, *manifest.Gitlab)
+	if err != nil {
+		return fail(err)
+	}
+	if project != nil {
+		published, err := isPublished(ctx, client, project)
+		if err != nil {
+			return fail(err)
+		}
+		if published {
+			result.Status, result.Project = BootstrapSkipped, project
+			return result
+		}
+	}
+
+	outputDirectory := manifest.Spring.OutputDirectory
+	if outputDirectory == "" {
+		base := options.OutputDirectory
+		if base == "" {
+			base = util.DefaultOutputDirectory
+		}
+		outputDirectory = filepath.Join(base, manifest.Spring.Name)
+	}
+	policy := options.Policy
+	if exists, _ := util.Exists(filepath.Join(outputDirectory, spring.ManifestFile)); exists && policy == "" {
+		// A failed bootstrap generated the service before, it is merged instead of failing on the existing directory.
+		policy = spring.MergePolicy
+	}
+	generated, err := Generate(ctx, manifest, Options{
+		OutputDirectory: outputDirectory,
+		Policy:          policy,
+		Preset:          options.Preset,
+		Progress: func(event Event) {
+			progress(event.Step)
+		},
+	})
+	if err != nil {
+		return fail(err)
+	}
+
+	progress(PublishStep)
+	if project == nil {
+		result.Project, result.DeployToken, err = Publish(ctx, client, manifest, generated.ProjectRoot)
+	} else {
+		result.Project, result.DeployToken, err = pushAndProvision(ctx, client, *manifest.Gitlab, project, generated.ProjectRoot)
+	}
+	if err != nil {
+		return fail(err)
+	}
+	result.Status = BootstrapCreated
+	return result
+}
+
+// isPublished reports whether the default branch of the project holds the ManifestFile, i.e. the generated project was
+// pushed to it. Projects created by a failed bootstrap have no default branch or a branch without the file.
+func isPublished(ctx context.Context, client *gitlab.Client, project *gitlab.GitlabProject) (bool, error) {
+	if project.DefaultBranch == "" {
+		return false, nil
+	}
+	data, err := client.RawFile(ctx, project.PathWithNamespace, spring.ManifestFile, project.DefaultBranch)
+	return data != nil, err
+}
@@ -0,0 +1,233 @@
+package rlctl_test
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/rocketlaunchercloud/rlctl/pkg/rlctl"
+	"github.com/rocketlaunchercloud/rlctl/util"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path"
+	"sync"
+	"testing"
+)
+
+func TestParseInventory(t *testing.T) {
+	defaults := rlctl.Sections{"spring": {"container-registry": "registry.example.com", "group": "org.example"}}
+	data := []byte(`
+defaults:
+  spring: {group: com.example}
+  gitlab: {namespace_id: 42, visibility: internal}
+services:
+  - spring: {name: orders}
+  - spring: {name: payments, language: kotlin}
+    gitlab: {name: Payments Service, container_registry: true}
+`)
+	inventory, err := rlctl.ParseInventory("services.yaml", data, defaults)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(inventory.Services) != 2 {
+		t.Fatalf("unexpected services %v", inventory.Services)
+	}
+	orders, payments := inventory.Services[0], inventory.Services[1]
+	if orders.Spring.Group != "com.example" || orders.Spring.DockerConfig.RegistryUrl != "registry.example.com" ||
+		orders.Spring.Language != "java" {
+		t.Errorf("the defaults are not applied: %+v", orders.Spring)
+	}
+	if orders.Gitlab.Name != "orders" || orders.Gitlab.NamespaceID != 42 || orders.Gitlab.Visibility != "internal" {
+		t.Errorf("unexpected gitlab settings %+v", orders.Gitlab)
+	}
+	if payments.Spring.Language != "kotlin" || payments.Gitlab.Name != "Payments Service" || !payments.Gitlab.ContainerRegistry {
+		t.Errorf("the service settings are not applied: %+v %+v", payments.Spring, payments.Gitlab)
+	}
+
+	csv := []byte("spring.name,spring.group,gitlab.namespace_id,spring.gitlab-ci-tags\norders,com.example,42,\"[docker, k8s]\"\npayments,com.example,42,\n")
+	if inventory, err = rlctl.ParseInventoryCSV("services.csv", csv, nil); err != nil {
+		t.Fatal(err)
+	}
+	if len(inventory.Services) != 2 || len(inventory.Services[0].Spring.GitLabCIConfig.Tags) != 2 || inventory.Services[1].Gitlab.NamespaceID != 42 {
+		t.Errorf("unexpected services %+v", inventory.Services)
+	}
+
+	invalid := map[string]string{
+		"no services":       "defaults: {}",
+		"unknown setting":   "services: [{spring: {name: orders, group: com.example, colour: blue}, gitlab: {namespace_id: 1}}]",
+		"missing namespace": "services: [{spring: {name: orders, group: com.example}}]",
+		"duplicate":         "defaults: {spring: {group: com.example}, gitlab: {namespace_id: 1}}\nservices: [{spring: {name: orders}}, {spring: {name: orders}}]",
+	}
+	for name, data := range invalid {
+		if _, err = rlctl.ParseInventory(name, []byte(data), nil); util.KindOf(err) != util.ValidationError {
+			t.Errorf("%s: expected a validation error, got %v", name, err)
+		}
+	}
+}
+
+func TestBootstrap(t *testing.T) {
+	root, err := ioutil.TempDir("", "rlctl")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+	remote := path.Join(root, "payments.git")
+	git(t, root, "init", "--bare", remote)
+	// Publish commits with the identity of the git config
+	for _, variable := range []string{"GIT_AUTHOR_NAME", "GIT_AUTHOR_EMAIL", "GIT_COMMITTER_NAME", "GIT_COMMITTER_EMAIL"} {
+		os.Setenv(variable, "test")
+		defer os.Unsetenv(variable)
+	}
+
+	var mutex sync.Mutex
+	created := map[string]bool{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		switch r.URL.EscapedPath() {
+		case "/namespaces/42":
+			w.Write([]byte(`{"id":42,"full_path":"shop"}`))
+		case "/projects/shop%2Forders":
+			w.Write([]byte(`{"id":1,"name":"orders","path_with_namespace":"shop/orders","default_branch":"main","web_url":"https://gitlab.example.com/shop/orders"}`))
+		case "/projects/shop%2Forders/repository/files/rlctl.yaml/raw":
+			w.Write([]byte("spring: {name: orders}\n"))
+		case "/projects":
+			var body map[string]interface{}
+			json.NewDecoder(r.Body).Decode(&body)
+			name, _ := body["name"].(string)
+			if name != "payments" || created[name] {
+				http.Error(w, `{"message":"unexpected project"}`, http.StatusBadRequest)
+				return
+			}
+			created[name] = true
+			w.Write([]byte(`{"id":2,"name":"payments","web_url":"https://gitlab.example.com/shop/payments","http_url_to_repo":"` + remote + `"}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	inventory, err := rlctl.ParseInventory("services.yaml", []byte(`
+defaults:
+  spring: {group: com.example, offline: true}
+  gitlab: {namespace_id: 42}
+services:
+  - spring: {name: orders}
+  - spring: {name: payments}
+  - spring: {name: invoices}
+    gitlab: {namespace_id: 7}
+`), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client := rlctl.NewGitlabClient("secret")
+	client.BaseUrl = server.URL
+	var done []string
+	options := rlctl.BootstrapOptions{
+		OutputDirectory: root,
+		Concurrency:     2,
+		Done: func(result rlctl.BootstrapResult) {
+			mutex.Lock()
+			defer mutex.Unlock()
+			done = append(done, result.Service)
+		},
+	}
+	results := rlctl.Bootstrap(context.Background(), client, *inventory, options)
+
+	if len(results) != 3 || len(done) != 3 {
+		t.Fatalf("unexpected results %+v", results)
+	}
+	if results[0].Service != "orders" || results[0].Status != rlctl.BootstrapSkipped || results[0].Project.WebUrl != "https://gitlab.example.com/shop/orders" {
+		t.Errorf("the existing project is not skipped: %+v", results[0])
+	}
+	if results[1].Status != rlctl.BootstrapCreated || results[1].Err != nil {
+		t.Errorf("payments is not created: %+v", results[1])
+	}
+	if results[2].Status != rlctl.BootstrapFailed || results[2].Err == nil {
+		t.Errorf("invoices in an unknown namespace must fail: %+v", results[2])
+	}
+	if git(t, remote, "ls-tree", "--name-only", util.DefaultGitBranch, "build.gradle") != "build.gradle" {
+		t.Error("payments is not pushed")
+	}
+	if _, err = os.Stat(path.Join(root, "orders")); !os.IsNotExist(err) {
+		t.Error("skipped services must not be generated")
+	}
+}
+
+func TestBootstrapResume(t *testing.T) {
+	root, err := ioutil.TempDir("", "rlctl")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+	// The remote does not exist before the second run, the first push fails after the project was created.
+	remote := path.Join(root, "payments.git")
+	for _, variable := range []string{"GIT_AUTHOR_NAME", "GIT_AUTHOR_EMAIL", "GIT_COMMITTER_NAME", "GIT_COMMITTER_EMAIL"} {
+		os.Setenv(variable, "test")
+		defer os.Unsetenv(variable)
+	}
+
+	created := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		pushed, _ := util.Exists(path.Join(remote, "refs", "heads", util.DefaultGitBranch))
+		project := `{"id":2,"name":"payments","path_with_namespace":"shop/payments","http_url_to_repo":"` + remote + `"`
+		if pushed {
+			project += `,"default_branch":"` + util.DefaultGitBranch + `"`
+		}
+		project += "}"
+		switch r.URL.EscapedPath() {
+		case "/namespaces/42":
+			w.Write([]byte(`{"id":42,"full_path":"shop"}`))
+		case "/projects/shop%2Fpayments":
+			if created == 0 {
+				http.NotFound(w, r)
+				return
+			}
+			w.Write([]byte(project))
+		case "/projects/shop%2Fpayments/repository/files/rlctl.yaml/raw":
+			if !pushed {
+				http.NotFound(w, r)
+				return
+			}
+			w.Write([]byte("spring: {name: payments}\n"))
+		case "/projects":
+			created++
+			w.Write([]byte(project))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	inventory, err := rlctl.ParseInventory("services.yaml", []byte(`
+defaults:
+  spring: {group: com.example, offline: true}
+  gitlab: {namespace_id: 42}
+services:
+  - spring: {name: payments}
+`), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := rlctl.NewGitlabClient("secret")
+	client.BaseUrl = server.URL
+	options := rlctl.BootstrapOptions{OutputDirectory: root, Concurrency: 1}
+
+	if results := rlctl.Bootstrap(context.Background(), client, *inventory, options); results[0].Status != rlctl.BootstrapFailed {
+		t.Fatalf("the push must fail: %+v", results[0])
+	}
+	git(t, root, "init", "--bare", remote)
+	if results := rlctl.Bootstrap(context.Background(), client, *inventory, options); results[0].Status != rlctl.BootstrapCreated || results[0].Err != nil {
+		t.Fatalf("the second run must push the created project: %+v", results[0])
+	}
+	if git(t, remote, "ls-tree", "--name-only", util.DefaultGitBranch, "rlctl.yaml") != "rlctl.yaml" {
+		t.Error("payments is not pushed")
+	}
+	if results := rlctl.Bootstrap(context.Background(), client, *inventory, options); results[0].Status != rlctl.BootstrapSkipped {
+		t.Errorf("the published project must be skipped: %+v", results[0])
+	}
+	if created != 1 {
+		t.Errorf("the project must be created once, it was created %d times", created)
+	}
+}
@@ -0,0 +1,79 @@
+package rlctl
+
+import (
+	"github.com/rocketlaunchercloud/rlctl/project/gitlab"
+	"github.com/rocketlaunchercloud/rlctl/project/spring"
+	"github.com/rocketlaunchercloud/rlctl/util"
+	"gopkg.in/yaml.v2"
+	"io/ioutil"
+	"reflect"
+	"strings"
+)
+
+// Manifest describes the project to generate and, optionally, the GitLab project hosting it. Manifests are stored
+// as YAML, the keys are the names of the corresponding rlctl flags. Tokens are never stored.
+type Manifest struct {
+	// TemplateVersion is set in the manifests stored in generated projects, see spring.ManifestFile.
+	TemplateVersion int                        `yaml:"template-version,omitempty" json:"template-version,omitempty"`
+	Spring          spring.SpringProjectConfig `yaml:"spring" json:"spring"`
+	Gitlab          *gitlab.GitlabConfig       `yaml:"gitlab,omitempty" json:"gitlab,omitempty"`
+}
+
+// NewManifest returns a manifest of the given project holding the defaults of the spring command.
+func NewManifest(name, group string) Manifest {
+	config := spring.DefaultSpringProjectConfig()
+	config.Name = name
+	config.Group = group
+	return Manifest{Spring: config}
+}
+
+// LoadManifest reads the manifest file. Settings missing in the file keep the defaults of the spring command.
+func LoadManifest(path string) (Manifest, error) {
+	manifest := Manifest{Spring: spring.DefaultSpringProjectConfig()}
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return manifest, err
+	}
+	if err = yaml.UnmarshalStrict(data, &manifest); err != nil {
+		return manifest, util.NewValidationError("%s: %v", path, err)
+	}
+	return manifest, nil
+}
+
+// SaveManifest writes the manifest file.
+func SaveManifest(path string, manifest Manifest) error {
+	data, err := yaml.Marshal(manifest)
+	if err != nil {
+		return err
+	}
+	return util.NewFileSystemError(path, ioutil.WriteFile(path, data, 0644))
+}
+
+// IsManifestSetting reports whether the key is a setting of the section of manifests. Flags like spring.git-push
+// configure a single run and are no manifest settings.
+func IsManifestSetting(section, key string) bool {
+	switch section {
+	case "spring":
+		return hasYamlKey(reflect.TypeOf(spring.SpringProjectConfig{}), key)
+	case "gitlab":
+		return hasYamlKey(reflect.TypeOf(gitlab.GitlabConfig{}), key)
+	}
+	return false
+}
+
+func hasYamlKey(structType reflect.Type, key string) bool {
+	for i := 0; i < structType.NumField(); i++ {
+		field := structType.Field(i)
+		tag := field.Tag.Get("yaml")
+		name := strings.Split(tag, ",")[0]
+		switch {
+		case strings.Contains(tag, ",inline") && field.Type.Kind() == reflect.Struct:
+			if hasYamlKey(field.Type, key) {
+				return true
+			}
+		case name == key:
+			return true
+		}
+	}
+	return false
+}
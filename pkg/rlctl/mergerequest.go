@@ -0,0 +1,119 @@
+package rlctl
+
+import (
+	"context"
+	"github.com/rocketlaunchercloud/rlctl/project/gitlab"
+	"github.com/rocketlaunchercloud/rlctl/util"
+	"strings"
+)
+
+const (
+	DefaultBootstrapBranch   = "rlctl/bootstrap"
+	DefaultMergeRequestTitle = "Bootstrap {{.Name}}"
+
+	mergeRequestTemplate = "gitlab/merge-request.md.tmpl"
+)
+
+// MergeRequestOptions control OpenMergeRequest, only RepositoryUrl is mandatory.
+type MergeRequestOptions struct {
+	// RepositoryUrl is the HTTPS or SSH url of an existing GitLab project.
+	RepositoryUrl string
+	// Project is the path with namespace of the project, it defaults to the path of RepositoryUrl.
+	Project string
+	// SourceBranch receives the generated files, it defaults to DefaultBootstrapBranch.
+	SourceBranch string
+	// TargetBranch defaults to the default branch of the project.
+	TargetBranch string
+	// Title is a template rendered with the spring settings, it defaults to DefaultMergeRequestTitle.
+	Title string
+	// Assignees and Reviewers are GitLab usernames.
+	Assignees []string
+	Reviewers []string
+	Labels    []string
+}
+
+// OpenMergeRequest commits the generated project on top of the target branch, pushes it to the source branch and opens
+// a merge request whose description summarises the enabled features. client must point to the GitLab instance of the
+// repository, the token of the client authenticates HTTPS pushes unless the repository has its own.
+func OpenMergeRequest(ctx context.Context, client *gitlab.Client, manifest Manifest, repository util.GitRepository, options MergeRequestOptions) (*gitlab.GitlabMergeRequest, error) {
+	projectPath := options.Project
+	if projectPath == "" {
+		var ok bool
+		if _, projectPath, ok = gitlab.ProjectFromRepositoryUrl(options.RepositoryUrl); !ok {
+			return nil, util.NewValidationError("%s is not the url of a GitLab project", options.RepositoryUrl)
+		}
+	}
+	if options.SourceBranch == "" {
+		options.SourceBranch = DefaultBootstrapBranch
+	}
+	if options.Title == "" {
+		options.Title = DefaultMergeRequestTitle
+	}
+	if repository.Token == "" {
+		repository.Token = client.Token
+	}
+
+	project, err := client.Project(ctx, projectPath)
+	if err != nil {
+		return nil, err
+	}
+	if options.TargetBranch == "" {
+		options.TargetBranch = project.DefaultBranch
+	}
+	if options.TargetBranch == "" {
+		return nil, util.NewValidationError("%s has no default branch, push the generated project instead", projectPath)
+	}
+
+	config := gitlab.MergeRequestConfig{
+		SourceBranch:       options.SourceBranch,
+		TargetBranch:       options.TargetBranch,
+		Labels:             strings.Join(options.Labels, ","),
+		RemoveSourceBranch: true,
+	}
+	if config.AssigneeIds, err = userIds(ctx, client, options.Assignees); err != nil {
+		return nil, err
+	}
+	if config.ReviewerIds, err = userIds(ctx, client, options.Reviewers); err != nil {
+		return nil, err
+	}
+	if config.Title, err = util.ParseTemplate(manifest.Spring, "title", options.Title); err != nil {
+		return nil, err
+	}
+	description, err := util.GetSpringTemplate(mergeRequestTemplate)
+	if err != nil {
+		return nil, err
+	}
+	if config.Description, err = util.ParseTemplate(manifest.Spring, mergeRequestTemplate, description); err != nil {
+		return nil, err
+	}
+
+	steps := []func() error{
+		func() error { return repository.Init(ctx) },
+		func() error { return repository.SetRemote(ctx, "origin", options.RepositoryUrl) },
+		func() error { return repository.StartBranch(ctx, "origin", options.TargetBranch, options.SourceBranch) },
+		func() error { return repository.AddAll(ctx) },
+		func() error { return repository.Commit(ctx, strings.TrimSpace(config.Title)) },
+		func() error { return repository.Push(ctx, "origin") },
+	}
+	for _, step := range steps {
+		if err = step(); err != nil {
+			return nil, err
+		}
+	}
+	return client.CreateMergeRequest(ctx, projectPath, config)
+}
+
+func userIds(ctx context.Context, client *gitlab.Client, usernames []string) ([]int32, error) {
+	var ids []int32
+	for _, username := range usernames {
+		users, err := client.Users(ctx, strings.TrimPrefix(username, "@"))
+		if err != nil {
+			return nil, err
+		}
+		if len(users) == 0 {
+			return nil, util.NewValidationError("unknown GitLab user %s", username)
+		}
+		ids = append(ids, users[0].Id)
+	}
+	return ids, nil
+}
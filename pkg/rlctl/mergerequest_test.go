@@ -0,0 +1,104 @@
+package rlctl_test
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/rocketlaunchercloud/rlctl/pkg/rlctl"
+	"github.com/rocketlaunchercloud/rlctl/project/gitlab"
+	"github.com/rocketlaunchercloud/rlctl/util"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"os/exec"
+	"path"
+	"strings"
+	"testing"
+)
+
+func git(t *testing.T, dir string, args ...string) string {
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), "GIT_AUTHOR_NAME=test", "GIT_AUTHOR_EMAIL=test@example.com",
+		"GIT_COMMITTER_NAME=test", "GIT_COMMITTER_EMAIL=test@example.com")
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		t.Fatal(args, string(output), err)
+	}
+	return strings.TrimSpace(string(output))
+}
+
+func TestOpenMergeRequest(t *testing.T) {
+	root, err := ioutil.TempDir("", "rlctl")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+
+	// The remote has a protected main branch holding a README
+	remote := path.Join(root, "demo.git")
+	git(t, root, "init", "--bare", remote)
+	seed := path.Join(root, "seed")
+	os.MkdirAll(seed, 0700)
+	ioutil.WriteFile(path.Join(seed, "README.md"), []byte("demo"), 0644)
+	git(t, seed, "init")
+	git(t, seed, "add", ".")
+	git(t, seed, "commit", "-m", "Initial")
+	git(t, seed, "push", remote, "HEAD:refs/heads/main")
+
+	var created gitlab.MergeRequestConfig
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.EscapedPath() {
+		case "/projects/group%2Fdemo":
+			w.Write([]byte(`{"id":1,"default_branch":"main"}`))
+		case "/users":
+			w.Write([]byte(`[{"id":7,"username":"` + r.URL.Query().Get("username") + `"}]`))
+		case "/projects/group%2Fdemo/merge_requests":
+			json.NewDecoder(r.Body).Decode(&created)
+			w.Write([]byte(`{"iid":1,"web_url":"https://gitlab.example.com/group/demo/-/merge_requests/1"}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	manifest := rlctl.NewManifest("demo", "com.example")
+	manifest.Spring.Offline = true
+	result, err := rlctl.Generate(context.Background(), manifest, rlctl.Options{OutputDirectory: path.Join(root, "demo")})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client := gitlab.NewClient("secret")
+	client.BaseUrl = server.URL
+	repository := util.GitRepository{Path: result.ProjectRoot, Author: "Jane Doe <jane@example.com>"}
+	options := rlctl.MergeRequestOptions{
+		RepositoryUrl: remote,
+		Project:       "group/demo",
+		Assignees:     []string{"jane"},
+		Labels:        []string{"bootstrap", "rlctl"},
+	}
+	mergeRequest, err := rlctl.OpenMergeRequest(context.Background(), client, manifest, repository, options)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mergeRequest.Iid != 1 {
+		t.Errorf("unexpected merge request %v", mergeRequest)
+	}
+	if created.SourceBranch != rlctl.DefaultBootstrapBranch || created.TargetBranch != "main" ||
+		created.Title != "Bootstrap demo" || created.Labels != "bootstrap,rlctl" || len(created.AssigneeIds) != 1 {
+		t.Errorf("unexpected merge request config %+v", created)
+	}
+	if !strings.Contains(created.Description, "JPA with MYSQL") {
+		t.Errorf("the description does not list the features: %s", created.Description)
+	}
+
+	// The branch is based on main and keeps its files
+	if parent := git(t, remote, "rev-parse", rlctl.DefaultBootstrapBranch+"^"); parent != git(t, remote, "rev-parse", "main") {
+		t.Error("the bootstrap branch is not based on main")
+	}
+	files := git(t, remote, "ls-tree", "--name-only", rlctl.DefaultBootstrapBranch)
+	if !strings.Contains(files, "README.md") || !strings.Contains(files, "build.gradle") {
+		t.Errorf("unexpected files %s", files)
+	}
+}
@@ -0,0 +1,40 @@
+package rlctl
+
+import (
+	"context"
+	"github.com/rocketlaunchercloud/rlctl/project/gitlab"
+	"github.com/rocketlaunchercloud/rlctl/util"
+)
+
+// Publish creates and provisions the GitLab project described by the gitlab section of the manifest and pushes the
+// generated project to it. The deploy token is returned if the manifest asks for one.
+func Publish(ctx context.Context, client *gitlab.Client, manifest Manifest, projectRoot string) (*gitlab.GitlabProject, *gitlab.GitlabDeployToken, error) {
+	if manifest.Gitlab == nil {
+		return nil, nil, util.NewValidationError("the manifest has no gitlab section")
+	}
+	config := *manifest.Gitlab
+	if err := util.ValidateRequired(config.Name, "name"); err != nil {
+		return nil, nil, err
+	}
+	if err := config.ProvisioningConfig.Validate(); err != nil {
+		return nil, nil, err
+	}
+	// A README commit created by GitLab would reject the push.
+	config.InitializeWithReadme = false
+
+	project, err := client.CreateProject(ctx, config)
+	if err != nil {
+		return nil, nil, err
+	}
+	return pushAndProvision(ctx, client, config, project, projectRoot)
+}
+
+// pushAndProvision pushes the generated project to the existing GitLab project and provisions it.
+func pushAndProvision(ctx context.Context, client *gitlab.Client, config gitlab.GitlabConfig, project *gitlab.GitlabProject, projectRoot string) (*gitlab.GitlabProject, *gitlab.GitlabDeployToken, error) {
+	repository := util.GitRepository{Path: projectRoot, Token: client.Token}
+	if err := repository.Publish(ctx, project.RepoHttpUrl, "Initial Commit!"); err != nil {
+		return project, nil, err
+	}
+	deployToken, err := client.Provision(ctx, project, config.ProvisioningConfig)
+	return project, deployToken, err
+}
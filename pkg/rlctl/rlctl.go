@@ -0,0 +1,90 @@
+// Package rlctl is the Go API of rlctl. It generates projects without touching global state or exiting the process,
+// so the generators can be embedded in other services.
+package rlctl
+
+import (
+	"context"
+	"github.com/rocketlaunchercloud/rlctl/project/gitlab"
+	"github.com/rocketlaunchercloud/rlctl/project/spring"
+	"github.com/rocketlaunchercloud/rlctl/util"
+	"path/filepath"
+	"time"
+)
+
+// Options control a single Generate call.
+type Options struct {
+	// OutputDirectory overrides the output directory of the manifest, it defaults to build/<name>.
+	OutputDirectory string
+	// Policy is applied when the output directory holds files: spring.FailPolicy (default), spring.ForcePolicy or
+	// spring.MergePolicy.
+	Policy string
+	// Progress is notified before every generation step, it may be nil.
+	Progress ProgressFunc
+	// Preset, if set, rejects manifests which change a setting locked by the preset. Its defaults are not applied,
+	// see spring.Preset.Apply.
+	Preset *spring.Preset
+}
+
+// Event reports the generation step which is about to start, see the spring.*Step constants.
+type Event struct {
+	Step string
+	Time time.Time
+}
+
+type ProgressFunc func(Event)
+
+// Result describes the generated project, Files are slash separated and relative to ProjectRoot. Conflicts are the
+// values of existing YAML files the merge policy kept although the templates changed them.
+type Result struct {
+	ProjectRoot string
+	Files       []string
+	Conflicts   []string
+}
+
+// Generate generates the project of the manifest. The manifest is not modified, the output directory is left
+// untouched if generation fails or ctx is done before it completes.
+func Generate(ctx context.Context, manifest Manifest, options Options) (*Result, error) {
+	config := manifest.Spring
+	if err := util.ValidateRequired(config.Name, "name"); err != nil {
+		return nil, err
+	}
+	if err := util.ValidateRequired(config.Group, "group"); err != nil {
+		return nil, err
+	}
+
+	if options.Preset != nil {
+		if err := options.Preset.Check(config); err != nil {
+			return nil, err
+		}
+	}
+
+	if options.OutputDirectory != "" {
+		config.OutputDirectory = options.OutputDirectory
+	}
+	if config.OutputDirectory == "" {
+		config.OutputDirectory = filepath.Join(util.DefaultOutputDirectory, config.Name)
+	}
+	outputDirectory, err := filepath.Abs(config.OutputDirectory)
+	if err != nil {
+		return nil, err
+	}
+	config.OutputDirectory = outputDirectory
+
+	var progress spring.ProgressFunc
+	if options.Progress != nil {
+		progress = func(step string) {
+			options.Progress(Event{Step: step, Time: time.Now()})
+		}
+	}
+
+	project, err := spring.Generate(ctx, &config, options.Policy, progress)
+	if err != nil {
+		return nil, err
+	}
+	return &Result{ProjectRoot: project.Root, Files: project.Files, Conflicts: project.Conflicts}, nil
+}
+
+// NewGitlabClient returns a client of the GitLab API authenticated with token.
+func NewGitlabClient(token string) *gitlab.Client {
+	return gitlab.NewClient(token)
+}
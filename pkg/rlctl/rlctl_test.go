@@ -0,0 +1,137 @@
+package rlctl_test
+
+import (
+	"context"
+	"github.com/rocketlaunchercloud/rlctl/pkg/rlctl"
+	"github.com/rocketlaunchercloud/rlctl/project/spring"
+	"github.com/rocketlaunchercloud/rlctl/util"
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+)
+
+// TestMain caches a Gradle wrapper jar in a temporary cache directory, offline generation requires one and the tests
+// must not use the cache of the user.
+func TestMain(m *testing.M) {
+	cache, err := ioutil.TempDir("", "rlctl-cache")
+	if err != nil {
+		panic(err)
+	}
+	os.Setenv(util.CacheDirectoryEnv, cache)
+	if err = util.PutCached("gradle/wrapper/gradle-wrapper.jar", []byte("JAR")); err != nil {
+		panic(err)
+	}
+	code := m.Run()
+	os.RemoveAll(cache)
+	os.Exit(code)
+}
+
+func TestGenerate(t *testing.T) {
+	root, err := ioutil.TempDir("", "rlctl")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+
+	manifest := rlctl.NewManifest("demo", "com.example")
+	manifest.Spring.Offline = true
+
+	var steps []string
+	options := rlctl.Options{
+		OutputDirectory: path.Join(root, "demo"),
+		Progress: func(event rlctl.Event) {
+			steps = append(steps, event.Step)
+		},
+	}
+	result, err := rlctl.Generate(context.Background(), manifest, options)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if steps[0] != spring.InitializrStep || steps[len(steps)-1] != spring.CommitStep {
+		t.Errorf("unexpected steps %v", steps)
+	}
+
+	files := map[string]bool{}
+	for _, file := range result.Files {
+		files[file] = true
+	}
+	for _, file := range []string{"build.gradle", "Dockerfile", ".gitlab-ci.yml", "kubernetes/prod/kube-config.yml"} {
+		if !files[file] {
+			t.Errorf("%s was not generated", file)
+		}
+		if _, err = os.Stat(path.Join(result.ProjectRoot, file)); err != nil {
+			t.Error(err)
+		}
+	}
+	if manifest.Spring.OutputDirectory != "" {
+		t.Error("the manifest must not be modified")
+	}
+}
+
+func TestGenerateCancelled(t *testing.T) {
+	root, err := ioutil.TempDir("", "rlctl")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+
+	manifest := rlctl.NewManifest("demo", "com.example")
+	manifest.Spring.Offline = true
+
+	ctx, cancel := context.WithCancel(context.Background())
+	options := rlctl.Options{
+		OutputDirectory: path.Join(root, "demo"),
+		Progress: func(event rlctl.Event) {
+			if event.Step == spring.PipelineStep {
+				cancel()
+			}
+		},
+	}
+	if _, err = rlctl.Generate(ctx, manifest, options); err != context.Canceled {
+		t.Fatalf("expected %v, got %v", context.Canceled, err)
+	}
+	if files, _ := ioutil.ReadDir(root); len(files) != 0 {
+		t.Errorf("expected nothing left behind, got %d entries", len(files))
+	}
+}
+
+func TestManifest(t *testing.T) {
+	dir, err := ioutil.TempDir("", "rlctl")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	manifestPath := path.Join(dir, "rlctl.yaml")
+	err = ioutil.WriteFile(manifestPath, []byte("spring:\n  name: demo\n  group: com.example\n  language: kotlin\n"), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	manifest, err := rlctl.LoadManifest(manifestPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if manifest.Spring.Language != spring.Kotlin || manifest.Spring.BuildTool != spring.Gradle {
+		t.Errorf("expected kotlin and the default build tool, got %s %s", manifest.Spring.Language, manifest.Spring.BuildTool)
+	}
+
+	manifest.Spring.SonarQubeConfig.SonarUserToken = "secret"
+	if err = rlctl.SaveManifest(manifestPath, manifest); err != nil {
+		t.Fatal(err)
+	}
+	saved, err := rlctl.LoadManifest(manifestPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if saved.Spring.Name != "demo" || saved.Spring.SonarQubeConfig.SonarUserToken != "" {
+		t.Errorf("unexpected manifest %+v", saved.Spring)
+	}
+
+	if err = ioutil.WriteFile(manifestPath, []byte("spring:\n  nme: demo\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = rlctl.LoadManifest(manifestPath); err == nil {
+		t.Error("expected unknown settings to be rejected")
+	}
+}
@@ -0,0 +1,139 @@
+package gitlab
+
+import (
+	"fmt"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	LintError   = "error"
+	LintWarning = "warning"
+)
+
+type LintIssue struct {
+	Job      string
+	Severity string
+	Message  string
+}
+
+type GitlabCILintResult struct {
+	Status   string   `json:"status"`
+	Errors   []string `json:"errors"`
+	Warnings []string `json:"warnings"`
+}
+
+var (
+	globalKeywords = map[string]bool{
+		"image": true, "services": true, "stages": true, "types": true, "before_script": true, "after_script": true,
+		"variables": true, "cache": true, "include": true, "workflow": true, "default": true,
+	}
+
+	jobKeywords = map[string]bool{
+		"script": true, "image": true, "services": true, "stage": true, "type": true, "before_script": true,
+		"after_script": true, "variables": true, "cache": true, "artifacts": true, "dependencies": true, "needs": true,
+		"tags": true, "except": true, "only": true, "rules": true, "when": true, "start_in": true, "allow_failure": true,
+		"environment": true, "coverage": true, "retry": true, "timeout": true, "parallel": true, "trigger": true,
+		"interruptible": true, "resource_group": true, "release": true, "extends": true, "secrets": true,
+	}
+
+	// Keywords which must not be left empty, GitLab rejects or silently misreads them.
+	nonEmptyJobKeywords = []string{"tags", "except", "only", "artifacts", "script", "stage"}
+
+	defaultStages = []string{"build", "test", "deploy"}
+)
+
+// LintCIConfig checks the content of a .gitlab-ci.yml without contacting GitLab.
+func LintCIConfig(content []byte) ([]LintIssue, error) {
+	var config yaml.MapSlice
+	if err := yaml.Unmarshal(content, &config); err != nil {
+		return nil, err
+	}
+
+	stages := map[string]bool{".pre": true, ".post": true}
+	declaredStages := defaultStages
+	for _, item := range config {
+		if item.Key == "stages" {
+			declaredStages = toStrings(item.Value)
+		}
+	}
+	for _, stage := range declaredStages {
+		stages[stage] = true
+	}
+
+	var issues []LintIssue
+	for _, item := range config {
+		name := fmt.Sprintf("%v", item.Key)
+		if globalKeywords[name] {
+			continue
+		}
+		job, ok := item.Value.(yaml.MapSlice)
+		if !ok {
+			issues = append(issues, LintIssue{Job: name, Severity: LintError, Message: "job must be a map of keywords"})
+			continue
+		}
+		// Hidden jobs are templates for other jobs and may be incomplete
+		if len(name) > 0 && name[0] == '.' {
+			continue
+		}
+		issues = append(issues, lintJob(name, job, stages)...)
+	}
+	return issues, nil
+}
+
+func lintJob(name string, job yaml.MapSlice, stages map[string]bool) []LintIssue {
+	var issues []LintIssue
+	keys := make(map[string]interface{})
+	for _, item := range job {
+		key := fmt.Sprintf("%v", item.Key)
+		keys[key] = item.Value
+		if !jobKeywords[key] {
+			issues = append(issues, LintIssue{Job: name, Severity: LintError, Message: fmt.Sprintf("unknown keyword %s", key)})
+		}
+	}
+
+	for _, key := range nonEmptyJobKeywords {
+		if value, ok := keys[key]; ok && isEmpty(value) {
+			issues = append(issues, LintIssue{Job: name, Severity: LintError, Message: fmt.Sprintf("%s must not be empty", key)})
+		}
+	}
+
+	_, hasScript := keys["script"]
+	_, hasTrigger := keys["trigger"]
+	_, hasExtends := keys["extends"]
+	if !hasScript && !hasTrigger && !hasExtends {
+		issues = append(issues, LintIssue{Job: name, Severity: LintError, Message: "script is missing"})
+	}
+
+	stage := "test"
+	if value, ok := keys["stage"]; ok && !isEmpty(value) {
+		stage = fmt.Sprintf("%v", value)
+	}
+	if !stages[stage] {
+		issues = append(issues, LintIssue{Job: name, Severity: LintError, Message: fmt.Sprintf("stage %s is not declared in stages", stage)})
+	}
+	return issues
+}
+
+func isEmpty(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case []interface{}:
+		return len(v) == 0
+	case yaml.MapSlice:
+		return len(v) == 0
+	}
+	return false
+}
+
+func toStrings(value interface{}) []string {
+	var result []string
+	if items, ok := value.([]interface{}); ok {
+		for _, item := range items {
+			result = append(result, fmt.Sprintf("%v", item))
+		}
+	}
+	return result
+}
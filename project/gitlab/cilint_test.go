@@ -0,0 +1,83 @@
+package gitlab_test
+
+import (
+	"github.com/rocketlaunchercloud/rlctl/project/gitlab"
+	"github.com/rocketlaunchercloud/rlctl/project/spring"
+	"io/ioutil"
+	"os"
+	"path"
+	"strings"
+	"testing"
+)
+
+func TestLintGeneratedCIConfig(t *testing.T) {
+	projectRoot, err := ioutil.TempDir("", "rlctl")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(projectRoot)
+
+	scripts := map[string]string{spring.Gradle: "./gradlew check", spring.Maven: "./mvnw -B test"}
+	for buildTool, script := range scripts {
+		var springProjectConfig spring.SpringProjectConfig
+		springProjectConfig.Name = "test"
+		springProjectConfig.BuildTool = buildTool
+		springProjectConfig.EnableSonar = true
+		if err = spring.ParseAndSaveCiCdFile(projectRoot, &springProjectConfig); err != nil {
+			t.Fatal(err)
+		}
+
+		content, err := ioutil.ReadFile(path.Join(projectRoot, ".gitlab-ci.yml"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		issues, err := gitlab.LintCIConfig(content)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(issues) != 0 {
+			t.Errorf("generated .gitlab-ci.yml of the %s is invalid: %v", buildTool, issues)
+		}
+		if !strings.Contains(string(content), script) {
+			t.Errorf("generated .gitlab-ci.yml of the %s misses %q:\n%s", buildTool, script, content)
+		}
+	}
+}
+
+func TestLintCIConfig(t *testing.T) {
+	content := []byte(`
+stages:
+  - build
+
+build:
+  stage: build
+  script:
+    - ./gradlew build
+  artifacts:
+  except:
+
+deploy:
+  stage: deploy
+  scripts:
+    - kubectl apply
+`)
+	issues, err := gitlab.LintCIConfig(content)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{
+		"except must not be empty",
+		"artifacts must not be empty",
+		"unknown keyword scripts",
+		"script is missing",
+		"stage deploy is not declared in stages",
+	}
+	if len(issues) != len(expected) {
+		t.Fatal("unexpected issues", issues)
+	}
+	for i, issue := range issues {
+		if issue.Message != expected[i] {
+			t.Errorf("expected %q, got %q", expected[i], issue.Message)
+		}
+	}
+}
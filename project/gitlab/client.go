@@ -0,0 +1,167 @@
+package gitlab
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/rocketlaunchercloud/rlctl/util"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// Client calls the GitLab API v4 on behalf of the owner of Token. All methods honour the cancellation and deadline
+// of their context.
+type Client struct {
+	BaseUrl string
+	Token   string
+}
+
+func NewClient(token string) *Client {
+	return &Client{BaseUrl: gitlabApiUrl, Token: token}
+}
+
+// CreateProject creates a new project in the namespace of the config, the provisioning settings are applied by
+// Provision.
+func (client *Client) CreateProject(ctx context.Context, gitlabConfig GitlabConfig) (*GitlabProject, error) {
+	project := &GitlabProject{}
+	gitlabConfig.ProvisioningConfig = ProvisioningConfig{}
+	if err := client.do(ctx, http.MethodPost, "/projects", gitlabConfig, project); err != nil {
+		return nil, err
+	}
+	return project, nil
+}
+
+// Namespaces lists the namespaces the token has access to.
+func (client *Client) Namespaces(ctx context.Context) ([]GitlabNamespace, error) {
+	namespaces := make([]GitlabNamespace, 0)
+	if err := client.do(ctx, http.MethodGet, "/namespaces", nil, &namespaces); err != nil {
+		return nil, err
+	}
+	return namespaces, nil
+}
+
+// Namespace returns the namespace with the id.
+func (client *Client) Namespace(ctx context.Context, id int32) (*GitlabNamespace, error) {
+	namespace := &GitlabNamespace{}
+	if err := client.do(ctx, http.MethodGet, "/namespaces/"+strconv.Itoa(int(id)), nil, namespace); err != nil {
+		return nil, err
+	}
+	return namespace, nil
+}
+
+// FindProject returns the project of the config in its namespace, nil if there is none. The path of projects without
+// Path is derived from the name like GitLab does, see ProjectPath.
+func (client *Client) FindProject(ctx context.Context, gitlabConfig GitlabConfig) (*GitlabProject, error) {
+	namespace, err := client.Namespace(ctx, gitlabConfig.NamespaceID)
+	if err != nil {
+		return nil, err
+	}
+	project, err := client.Project(ctx, namespace.FullPath+"/"+ProjectPath(gitlabConfig))
+	if util.HttpStatus(err) == http.StatusNotFound {
+		return nil, nil
+	}
+	return project, err
+}
+
+// ProjectPath returns the path of the project, the name lowercased with dashes if the config has no path.
+func ProjectPath(gitlabConfig GitlabConfig) string {
+	if gitlabConfig.Path != "" {
+		return gitlabConfig.Path
+	}
+	path := strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9', r == '_', r == '.', r == '-':
+			return r
+		case r >= 'A' && r <= 'Z':
+			return r - 'A' + 'a'
+		}
+		return '-'
+	}, gitlabConfig.Name)
+	return strings.Trim(path, "-_.")
+}
+
+// GroupProjects lists the projects of the group with the numeric id or the full path, including the projects of its
+// subgroups. Archived projects are left out.
+func (client *Client) GroupProjects(ctx context.Context, group string) ([]GitlabProject, error) {
+	const perPage = 100
+	projects := make([]GitlabProject, 0)
+	for page := 1; ; page++ {
+		var batch []GitlabProject
+		resource := fmt.Sprintf("/groups/%s/projects?include_subgroups=true&archived=false&order_by=path&sort=asc&per_page=%d&page=%d",
+			url.PathEscape(group), perPage, page)
+		if err := client.do(ctx, http.MethodGet, resource, nil, &batch); err != nil {
+			return nil, err
+		}
+		projects = append(projects, batch...)
+		if len(batch) < perPage {
+			return projects, nil
+		}
+	}
+}
+
+// RawFile returns the content of the file at the ref of the project, nil if the file does not exist.
+func (client *Client) RawFile(ctx context.Context, id, file, ref string) ([]byte, error) {
+	resource := "/projects/" + url.PathEscape(id) + "/repository/files/" + url.PathEscape(file) + "/raw?ref=" + url.QueryEscape(ref)
+	data, err := client.request(ctx, http.MethodGet, resource, nil)
+	if util.HttpStatus(err) == http.StatusNotFound {
+		return nil, nil
+	}
+	return data, err
+}
+
+// LintCI validates the content of a .gitlab-ci.yml with the GitLab CI Lint API.
+func (client *Client) LintCI(ctx context.Context, content []byte) (*GitlabCILintResult, error) {
+	result := &GitlabCILintResult{}
+	body := map[string]string{"content": string(content)}
+	if err := client.do(ctx, http.MethodPost, "/ci/lint", body, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// do sends body as JSON to the resource and decodes the JSON response into result.
+func (client *Client) do(ctx context.Context, method, resource string, body, result interface{}) error {
+	data, err := client.request(ctx, method, resource, body)
+	if err != nil {
+		return err
+	}
+	if err = json.Unmarshal(data, result); err != nil {
+		return util.NewNetworkError(client.BaseUrl+resource, err)
+	}
+	return nil
+}
+
+// request sends body as JSON to the resource and returns the response.
+func (client *Client) request(ctx context.Context, method, resource string, body interface{}) ([]byte, error) {
+	url := client.BaseUrl + resource
+
+	var reader io.Reader
+	if body != nil {
+		marshaledBody, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+		reader = bytes.NewReader(marshaledBody)
+	}
+
+	req, err := http.NewRequest(method, url, reader)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("PRIVATE-TOKEN", client.Token)
+
+	ch := make(chan util.ChannelResponse)
+	defer close(ch)
+	go util.MakeHttpRequest(req, ch)
+	channelResponse := <-ch
+	if !channelResponse.Success {
+		return nil, channelResponse.Error
+	}
+	return channelResponse.Data, nil
+}
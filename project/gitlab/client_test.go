@@ -0,0 +1,53 @@
+package gitlab_test
+
+import (
+	"context"
+	"github.com/rocketlaunchercloud/rlctl/project/gitlab"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestClientNamespaces(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/namespaces" || r.Header.Get("PRIVATE-TOKEN") != "secret" {
+			http.Error(w, `{"message":"401 Unauthorized"}`, http.StatusUnauthorized)
+			return
+		}
+		w.Write([]byte(`[{"id":1,"name":"team","full_path":"group/team"}]`))
+	}))
+	defer server.Close()
+
+	client := gitlab.NewClient("secret")
+	client.BaseUrl = server.URL
+	namespaces, err := client.Namespaces(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(namespaces) != 1 || namespaces[0].FullPath != "group/team" {
+		t.Errorf("unexpected namespaces %v", namespaces)
+	}
+
+	client.Token = "wrong"
+	if _, err = client.Namespaces(context.Background()); err == nil {
+		t.Error("expected an error for status 401")
+	}
+}
+
+func TestProjectFromRepositoryUrl(t *testing.T) {
+	urls := map[string][2]string{
+		"https://gitlab.example.com/group/sub/service.git": {"https://gitlab.example.com/api/v4", "group/sub/service"},
+		"http://localhost:8080/group/service":              {"http://localhost:8080/api/v4", "group/service"},
+		"git@gitlab.example.com:group/service.git":         {"https://gitlab.example.com/api/v4", "group/service"},
+		"ssh://git@gitlab.example.com:2222/group/service":  {"https://gitlab.example.com/api/v4", "group/service"},
+	}
+	for repositoryUrl, expected := range urls {
+		apiUrl, project, ok := gitlab.ProjectFromRepositoryUrl(repositoryUrl)
+		if !ok || apiUrl != expected[0] || project != expected[1] {
+			t.Errorf("%s: unexpected %s %s %v", repositoryUrl, apiUrl, project, ok)
+		}
+	}
+	if _, _, ok := gitlab.ProjectFromRepositoryUrl("/tmp/service.git"); ok {
+		t.Error("local paths are no GitLab projects")
+	}
+}
@@ -1,24 +1,21 @@
 package gitlab
 
-import (
-	"bytes"
-	"encoding/json"
-	"fmt"
-	"github.com/rocketlaunchercloud/rlctl/util"
-	"net/http"
+const (
+	gitlabApiUrl = "https://git.flix.tech/api/v4"
 )
 
 type GitlabConfig struct {
-	Token                                     string `json:-`
-	Name                                      string `json:"name"`
-	Path                                      string `json:"path,omitempty"`
-	NamespaceID                               int32  `json:"namespace_id"`
-	Visibility                                string `json:"visibility,omitempty"`
-	OnlyAllowMergeIfPipelineSucceeds          bool   `json:"only_allow_merge_if_pipeline_succeeds,omitempty"`
-	OnlyAllowMergeIfAllDiscussionsAreResolved bool   `json:"only_allow_merge_if_all_discussions_are_resolved,omitempty"`
-	AutoCancelPendingPipelines                string `json:"auto_cancel_pending_pipelines,omitempty"`
-	ApprovalsBeforeMerge                      int32  `json:"approvals_before_merge,omitempty"`
-	InitializeWithReadme                      bool   `json:"initialize_with_readme,omitempty"`
+	Token                                     string `json:"-" yaml:"-"`
+	Name                                      string `json:"name" yaml:"name"`
+	Path                                      string `json:"path,omitempty" yaml:"path,omitempty"`
+	NamespaceID                               int32  `json:"namespace_id" yaml:"namespace_id"`
+	Visibility                                string `json:"visibility,omitempty" yaml:"visibility,omitempty"`
+	OnlyAllowMergeIfPipelineSucceeds          bool   `json:"only_allow_merge_if_pipeline_succeeds,omitempty" yaml:"only_allow_merge_if_pipeline_succeeds"`
+	OnlyAllowMergeIfAllDiscussionsAreResolved bool   `json:"only_allow_merge_if_all_discussions_are_resolved,omitempty" yaml:"only_allow_merge_if_all_discussions_are_resolved"`
+	AutoCancelPendingPipelines                string `json:"auto_cancel_pending_pipelines,omitempty" yaml:"auto_cancel_pending_pipelines,omitempty"`
+	ApprovalsBeforeMerge                      int32  `json:"approvals_before_merge,omitempty" yaml:"approvals_before_merge"`
+	InitializeWithReadme                      bool   `json:"initialize_with_readme,omitempty" yaml:"initialize_with_readme"`
+	ProvisioningConfig                        `yaml:",inline"`
 }
 
 type GitlabProject struct {
@@ -49,57 +46,3 @@ type GitlabNamespace struct {
 	WebUrl               string `json:"web_url"`
 	BillableMembersCount int32  `json:"billable_members_count"`
 }
-
-func GitCreateProject(gitlabConfig GitlabConfig) (*GitlabProject, error) {
-	marshaledBody, err := json.Marshal(gitlabConfig)
-	if err != nil {
-		return nil, err
-	}
-	url := fmt.Sprintf("https://git.flix.tech/api/v4/projects?private_token=%s", gitlabConfig.Token)
-	req, err := http.NewRequest("POST", url, bytes.NewReader(marshaledBody))
-	if err != nil {
-		return nil, err
-	}
-
-	req.Header.Set("Content-Type", "application/json")
-
-	ch := make(chan util.ChannelResponse)
-	defer close(ch)
-	go util.MakeHttpRequest(req, ch)
-	channelResponse := <-ch
-	if channelResponse.Success {
-		data := &GitlabProject{}
-		err = json.Unmarshal(channelResponse.Data, &data)
-		if err != nil {
-			return nil, err
-		}
-		return data, nil
-	}
-	return nil, channelResponse.Error
-}
-
-func GitGetNamespaces(token string) ([]GitlabNamespace, error) {
-	req, err := http.NewRequest("GET", "https://git.flix.tech/api/v4/namespaces", nil)
-
-	if err != nil {
-		return nil, err
-	}
-
-	req.Header.Set("PRIVATE-TOKEN", token)
-
-	ch := make(chan util.ChannelResponse)
-	defer close(ch)
-
-	go util.MakeHttpRequest(req, ch)
-
-	channelResponse := <-ch
-	if channelResponse.Success {
-		data := make([]GitlabNamespace, 0)
-		err = json.Unmarshal(channelResponse.Data, &data)
-		if err != nil {
-			return nil, err
-		}
-		return data, nil
-	}
-	return nil, err
-}
@@ -0,0 +1,88 @@
+package gitlab
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// MergeRequestConfig describes a merge request, AssigneeIds and ReviewerIds are GitLab user ids.
+type MergeRequestConfig struct {
+	SourceBranch       string  `json:"source_branch"`
+	TargetBranch       string  `json:"target_branch"`
+	Title              string  `json:"title"`
+	Description        string  `json:"description,omitempty"`
+	AssigneeIds        []int32 `json:"assignee_ids,omitempty"`
+	ReviewerIds        []int32 `json:"reviewer_ids,omitempty"`
+	Labels             string  `json:"labels,omitempty"`
+	RemoveSourceBranch bool    `json:"remove_source_branch"`
+}
+
+type GitlabMergeRequest struct {
+	Id           int32  `json:"id"`
+	Iid          int32  `json:"iid"`
+	Title        string `json:"title"`
+	State        string `json:"state"`
+	SourceBranch string `json:"source_branch"`
+	TargetBranch string `json:"target_branch"`
+	WebUrl       string `json:"web_url"`
+}
+
+type GitlabUser struct {
+	Id       int32  `json:"id"`
+	Username string `json:"username"`
+	Name     string `json:"name"`
+}
+
+// Project returns the project with the numeric id or the path with namespace, e.g. group/service.
+func (client *Client) Project(ctx context.Context, id string) (*GitlabProject, error) {
+	project := &GitlabProject{}
+	if err := client.do(ctx, http.MethodGet, "/projects/"+url.PathEscape(id), nil, project); err != nil {
+		return nil, err
+	}
+	return project, nil
+}
+
+// Users returns the users with the username, the result is empty for unknown usernames.
+func (client *Client) Users(ctx context.Context, username string) ([]GitlabUser, error) {
+	users := make([]GitlabUser, 0)
+	if err := client.do(ctx, http.MethodGet, "/users?username="+url.QueryEscape(username), nil, &users); err != nil {
+		return nil, err
+	}
+	return users, nil
+}
+
+// CreateMergeRequest opens a merge request in the project with the numeric id or the path with namespace.
+func (client *Client) CreateMergeRequest(ctx context.Context, id string, config MergeRequestConfig) (*GitlabMergeRequest, error) {
+	mergeRequest := &GitlabMergeRequest{}
+	resource := "/projects/" + url.PathEscape(id) + "/merge_requests"
+	if err := client.do(ctx, http.MethodPost, resource, config, mergeRequest); err != nil {
+		return nil, err
+	}
+	return mergeRequest, nil
+}
+
+// ProjectFromRepositoryUrl returns the API url of the GitLab instance and the path with namespace of the project a
+// HTTPS or SSH repository url points to, e.g. git@gitlab.example.com:group/service.git.
+func ProjectFromRepositoryUrl(repositoryUrl string) (string, string, bool) {
+	var host, path string
+	if parsed, err := url.Parse(repositoryUrl); err == nil && parsed.Host != "" {
+		host, path = parsed.Hostname(), parsed.Path
+		if parsed.Scheme == "http" || parsed.Scheme == "https" {
+			host = parsed.Host
+		}
+	} else if at, colon := strings.Index(repositoryUrl, "@"), strings.Index(repositoryUrl, ":"); at >= 0 && colon > at {
+		// scp-like syntax of SSH urls
+		host, path = repositoryUrl[at+1:colon], repositoryUrl[colon+1:]
+	}
+	path = strings.TrimSuffix(strings.Trim(path, "/"), ".git")
+	if host == "" || path == "" {
+		return "", "", false
+	}
+	scheme := "https"
+	if strings.HasPrefix(repositoryUrl, "http://") {
+		scheme = "http"
+	}
+	return scheme + "://" + host + "/api/v4", path, true
+}
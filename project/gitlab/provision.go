@@ -0,0 +1,230 @@
+package gitlab
+
+import (
+	"context"
+	"github.com/rocketlaunchercloud/rlctl/util"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// The environments of the generated pipeline, deploy-version deploys to staging and deploy-manual to production.
+	StagingEnvironment    = "staging"
+	ProductionEnvironment = "production"
+
+	DefaultCleanupCadence   = "1d"
+	DefaultCleanupKeepN     = 10
+	DefaultCleanupOlderThan = "90d"
+	DefaultCleanupNameRegex = ".*"
+)
+
+var (
+	DefaultDeployTokenScopes = []string{"read_registry"}
+
+	cleanupCadences   = []string{"1d", "7d", "14d", "1month", "3month"}
+	cleanupKeepNs     = []string{"1", "5", "10", "25", "50", "100"}
+	cleanupOlderThans = []string{"7d", "14d", "30d", "90d"}
+	deployTokenScopes = []string{"read_repository", "read_registry", "write_registry", "read_package_registry", "write_package_registry"}
+)
+
+// ProvisioningConfig describes what is set up in a new project besides the repository. Its fields are not part of the
+// create project request, empty fields set up nothing or fall back to the defaults above.
+type ProvisioningConfig struct {
+	// ContainerRegistry enables the container registry with the cleanup policy of the ContainerCleanup fields.
+	ContainerRegistry             bool   `json:"container_registry,omitempty" yaml:"container_registry,omitempty"`
+	ContainerCleanupCadence       string `json:"container_cleanup_cadence,omitempty" yaml:"container_cleanup_cadence,omitempty"`
+	ContainerCleanupKeepN         int32  `json:"container_cleanup_keep_n,omitempty" yaml:"container_cleanup_keep_n,omitempty"`
+	ContainerCleanupOlderThan     string `json:"container_cleanup_older_than,omitempty" yaml:"container_cleanup_older_than,omitempty"`
+	ContainerCleanupNameRegex     string `json:"container_cleanup_name_regex,omitempty" yaml:"container_cleanup_name_regex,omitempty"`
+	ContainerCleanupNameRegexKeep string `json:"container_cleanup_name_regex_keep,omitempty" yaml:"container_cleanup_name_regex_keep,omitempty"`
+	// DeployToken is the name of a deploy token, e.g. for the image pull secret of the clusters.
+	DeployToken          string   `json:"deploy_token,omitempty" yaml:"deploy_token,omitempty"`
+	DeployTokenScopes    []string `json:"deploy_token_scopes,omitempty" yaml:"deploy_token_scopes,omitempty"`
+	DeployTokenExpiresAt string   `json:"deploy_token_expires_at,omitempty" yaml:"deploy_token_expires_at,omitempty"`
+	// Environments registers the staging and production environments of the generated pipeline.
+	Environments  bool   `json:"environments,omitempty" yaml:"environments,omitempty"`
+	StagingUrl    string `json:"staging_url,omitempty" yaml:"staging_url,omitempty"`
+	ProductionUrl string `json:"production_url,omitempty" yaml:"production_url,omitempty"`
+}
+
+type ContainerExpirationPolicy struct {
+	Enabled         bool   `json:"enabled"`
+	Cadence         string `json:"cadence"`
+	KeepN           int32  `json:"keep_n"`
+	OlderThan       string `json:"older_than"`
+	NameRegexDelete string `json:"name_regex_delete"`
+	NameRegexKeep   string `json:"name_regex_keep,omitempty"`
+}
+
+type DeployTokenConfig struct {
+	Name      string   `json:"name"`
+	Scopes    []string `json:"scopes"`
+	ExpiresAt string   `json:"expires_at,omitempty"`
+}
+
+// GitlabDeployToken is a deploy token, GitLab returns Token only when the token is created.
+type GitlabDeployToken struct {
+	Id        int32    `json:"id"`
+	Name      string   `json:"name"`
+	Username  string   `json:"username"`
+	Token     string   `json:"token"`
+	ExpiresAt string   `json:"expires_at"`
+	Scopes    []string `json:"scopes"`
+}
+
+type EnvironmentConfig struct {
+	Name        string `json:"name"`
+	ExternalUrl string `json:"external_url,omitempty"`
+}
+
+type GitlabEnvironment struct {
+	Id          int32  `json:"id"`
+	Name        string `json:"name"`
+	ExternalUrl string `json:"external_url"`
+	State       string `json:"state"`
+}
+
+// Validate checks the values GitLab accepts before a project is created.
+func (config ProvisioningConfig) Validate() error {
+	keepN := ""
+	if config.ContainerCleanupKeepN != 0 {
+		keepN = strconv.Itoa(int(config.ContainerCleanupKeepN))
+	}
+	if err := checkOneOf("container_cleanup_cadence", config.ContainerCleanupCadence, cleanupCadences); err != nil {
+		return err
+	}
+	if err := checkOneOf("container_cleanup_keep_n", keepN, cleanupKeepNs); err != nil {
+		return err
+	}
+	if err := checkOneOf("container_cleanup_older_than", config.ContainerCleanupOlderThan, cleanupOlderThans); err != nil {
+		return err
+	}
+	for _, scope := range config.DeployTokenScopes {
+		if err := checkOneOf("deploy_token_scopes", scope, deployTokenScopes); err != nil {
+			return err
+		}
+	}
+
+	if config.DeployTokenExpiresAt != "" {
+		if _, err := time.Parse("2006-01-02", config.DeployTokenExpiresAt); err != nil {
+			return util.NewValidationError("invalid deploy_token_expires_at %s, expected YYYY-MM-DD", config.DeployTokenExpiresAt)
+		}
+	}
+	for key, value := range map[string]string{"staging_url": config.StagingUrl, "production_url": config.ProductionUrl} {
+		if value == "" {
+			continue
+		}
+		if parsed, err := url.Parse(value); err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+			return util.NewValidationError("invalid %s %s, expected an http(s) url", key, value)
+		}
+	}
+	return nil
+}
+
+// ExpirationPolicy returns the cleanup policy of the container registry, empty fields are set to the defaults.
+func (config ProvisioningConfig) ExpirationPolicy() ContainerExpirationPolicy {
+	policy := ContainerExpirationPolicy{
+		Enabled:         true,
+		Cadence:         config.ContainerCleanupCadence,
+		KeepN:           config.ContainerCleanupKeepN,
+		OlderThan:       config.ContainerCleanupOlderThan,
+		NameRegexDelete: config.ContainerCleanupNameRegex,
+		NameRegexKeep:   config.ContainerCleanupNameRegexKeep,
+	}
+	if policy.Cadence == "" {
+		policy.Cadence = DefaultCleanupCadence
+	}
+	if policy.KeepN == 0 {
+		policy.KeepN = DefaultCleanupKeepN
+	}
+	if policy.OlderThan == "" {
+		policy.OlderThan = DefaultCleanupOlderThan
+	}
+	if policy.NameRegexDelete == "" {
+		policy.NameRegexDelete = DefaultCleanupNameRegex
+	}
+	return policy
+}
+
+// EnableContainerRegistry enables the container registry of the project with the cleanup policy.
+func (client *Client) EnableContainerRegistry(ctx context.Context, id string, policy ContainerExpirationPolicy) (*GitlabProject, error) {
+	project := &GitlabProject{}
+	body := map[string]interface{}{
+		"container_registry_enabled":             true,
+		"container_expiration_policy_attributes": policy,
+	}
+	if err := client.do(ctx, http.MethodPut, "/projects/"+url.PathEscape(id), body, project); err != nil {
+		return nil, err
+	}
+	return project, nil
+}
+
+// CreateDeployToken creates a deploy token of the project, the token of the result is not shown again by GitLab.
+func (client *Client) CreateDeployToken(ctx context.Context, id string, config DeployTokenConfig) (*GitlabDeployToken, error) {
+	token := &GitlabDeployToken{}
+	if err := client.do(ctx, http.MethodPost, "/projects/"+url.PathEscape(id)+"/deploy_tokens", config, token); err != nil {
+		return nil, err
+	}
+	return token, nil
+}
+
+// CreateEnvironment registers an environment of the project.
+func (client *Client) CreateEnvironment(ctx context.Context, id string, config EnvironmentConfig) (*GitlabEnvironment, error) {
+	environment := &GitlabEnvironment{}
+	if err := client.do(ctx, http.MethodPost, "/projects/"+url.PathEscape(id)+"/environments", config, environment); err != nil {
+		return nil, err
+	}
+	return environment, nil
+}
+
+// Provision sets up the container registry, the deploy token and the environments of the project. It returns the
+// deploy token if one was created.
+func (client *Client) Provision(ctx context.Context, project *GitlabProject, config ProvisioningConfig) (*GitlabDeployToken, error) {
+	id := strconv.Itoa(int(project.Id))
+	if config.ContainerRegistry {
+		if _, err := client.EnableContainerRegistry(ctx, id, config.ExpirationPolicy()); err != nil {
+			return nil, err
+		}
+	}
+
+	if config.Environments {
+		environments := []EnvironmentConfig{
+			{Name: StagingEnvironment, ExternalUrl: config.StagingUrl},
+			{Name: ProductionEnvironment, ExternalUrl: config.ProductionUrl},
+		}
+		for _, environment := range environments {
+			if _, err := client.CreateEnvironment(ctx, id, environment); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if config.DeployToken == "" {
+		return nil, nil
+	}
+	scopes := config.DeployTokenScopes
+	if len(scopes) == 0 {
+		scopes = DefaultDeployTokenScopes
+	}
+	return client.CreateDeployToken(ctx, id, DeployTokenConfig{
+		Name:      config.DeployToken,
+		Scopes:    scopes,
+		ExpiresAt: config.DeployTokenExpiresAt,
+	})
+}
+
+// checkOneOf accepts empty values and the allowed values.
+func checkOneOf(key, value string, allowed []string) error {
+	if value == "" {
+		return nil
+	}
+	for _, candidate := range allowed {
+		if candidate == value {
+			return nil
+		}
+	}
+	return util.NewValidationError("invalid %s %s, expected one of %s", key, value, strings.Join(allowed, ", "))
+}
@@ -0,0 +1,101 @@
+package gitlab_test
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/rocketlaunchercloud/rlctl/project/gitlab"
+	"github.com/rocketlaunchercloud/rlctl/util"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestProvision(t *testing.T) {
+	var requests []string
+	var policy gitlab.ContainerExpirationPolicy
+	var environments []gitlab.EnvironmentConfig
+	var deployToken gitlab.DeployTokenConfig
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		switch r.URL.Path {
+		case "/projects/7":
+			var body struct {
+				Enabled bool                             `json:"container_registry_enabled"`
+				Policy  gitlab.ContainerExpirationPolicy `json:"container_expiration_policy_attributes"`
+			}
+			json.NewDecoder(r.Body).Decode(&body)
+			if !body.Enabled {
+				t.Error("the container registry is not enabled")
+			}
+			policy = body.Policy
+			w.Write([]byte(`{"id":7}`))
+		case "/projects/7/environments":
+			var environment gitlab.EnvironmentConfig
+			json.NewDecoder(r.Body).Decode(&environment)
+			environments = append(environments, environment)
+			w.Write([]byte(`{"id":1,"name":"` + environment.Name + `"}`))
+		case "/projects/7/deploy_tokens":
+			json.NewDecoder(r.Body).Decode(&deployToken)
+			w.Write([]byte(`{"id":3,"name":"cluster","username":"gitlab+deploy-token-3","token":"s3cr3t"}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	client := gitlab.NewClient("secret")
+	client.BaseUrl = server.URL
+	token, err := client.Provision(context.Background(), &gitlab.GitlabProject{Id: 7}, gitlab.ProvisioningConfig{
+		ContainerRegistry:         true,
+		ContainerCleanupOlderThan: "30d",
+		DeployToken:               "cluster",
+		Environments:              true,
+		StagingUrl:                "https://orders.stg.example.com",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(requests) != 4 {
+		t.Errorf("unexpected requests %v", requests)
+	}
+	if policy.Cadence != gitlab.DefaultCleanupCadence || policy.KeepN != gitlab.DefaultCleanupKeepN || policy.OlderThan != "30d" {
+		t.Errorf("unexpected cleanup policy %+v", policy)
+	}
+	if len(environments) != 2 || environments[0].Name != gitlab.StagingEnvironment || environments[0].ExternalUrl != "https://orders.stg.example.com" ||
+		environments[1].Name != gitlab.ProductionEnvironment || environments[1].ExternalUrl != "" {
+		t.Errorf("unexpected environments %+v", environments)
+	}
+	if len(deployToken.Scopes) != 1 || deployToken.Scopes[0] != "read_registry" {
+		t.Errorf("unexpected deploy token scopes %v", deployToken.Scopes)
+	}
+	if token == nil || token.Username != "gitlab+deploy-token-3" || token.Token != "s3cr3t" {
+		t.Errorf("unexpected deploy token %+v", token)
+	}
+
+	requests = nil
+	if token, err = client.Provision(context.Background(), &gitlab.GitlabProject{Id: 7}, gitlab.ProvisioningConfig{}); err != nil || token != nil || len(requests) != 0 {
+		t.Errorf("nothing should be provisioned: %v %v %v", token, err, requests)
+	}
+}
+
+func TestProvisioningConfigValidate(t *testing.T) {
+	invalid := []gitlab.ProvisioningConfig{
+		{ContainerCleanupCadence: "2d"},
+		{ContainerCleanupKeepN: 7},
+		{ContainerCleanupOlderThan: "1y"},
+		{DeployTokenScopes: []string{"api"}},
+		{DeployTokenExpiresAt: "next week"},
+		{StagingUrl: "orders.stg.example.com"},
+	}
+	for _, config := range invalid {
+		if err := config.Validate(); util.KindOf(err) != util.ValidationError {
+			t.Errorf("%+v: expected a validation error, got %v", config, err)
+		}
+	}
+
+	valid := gitlab.ProvisioningConfig{ContainerCleanupKeepN: 25, DeployTokenExpiresAt: "2027-01-31", ProductionUrl: "https://orders.example.com"}
+	if err := valid.Validate(); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,358 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"github.com/rocketlaunchercloud/rlctl/pkg/rlctl"
+	"github.com/rocketlaunchercloud/rlctl/project/gitlab"
+	"github.com/rocketlaunchercloud/rlctl/project/spring"
+	"github.com/rocketlaunchercloud/rlctl/util"
+	"io/ioutil"
+	"log"
+	"mime"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	indexTemplate = "server/index.html"
+
+	// maxPreviewSize limits the content of a previewed file, larger files are listed without content.
+	maxPreviewSize = 64 * 1024
+	maxRequestSize = 1024 * 1024
+
+	// SessionHeader carries the session token of the web UI, it allows requests to use the token of the server.
+	SessionHeader = "X-Rlctl-Session"
+)
+
+// Server serves the web UI and the JSON API of the generator.
+type Server struct {
+	// address is the address the server listens on, requests for other hosts are rejected.
+	address string
+	// token is used for the GitLab API by requests of the web UI without a PRIVATE-TOKEN header.
+	token string
+	// session is a random token embedded in the web UI, requests carrying it were sent by the UI of this process.
+	session string
+	// preset, if set, provides the defaults and rejects requests changing its locked settings.
+	preset *spring.Preset
+	mux    *http.ServeMux
+}
+
+// Options lists the values the enumerated settings accept.
+type Options struct {
+	Language    []string `json:"language"`
+	BuildTool   []string `json:"build-tool"`
+	Packaging   []string `json:"packaging"`
+	CIPipeline  []string `json:"ci"`
+	JpaDatabase []string `json:"jpa-database"`
+}
+
+type defaultsResponse struct {
+	Manifest rlctl.Manifest `json:"manifest"`
+	Options  Options        `json:"options"`
+	Locked   []string       `json:"locked"`
+}
+
+type previewFile struct {
+	Path    string `json:"path"`
+	Size    int64  `json:"size"`
+	Content string `json:"content,omitempty"`
+}
+
+type previewResponse struct {
+	Files []previewFile `json:"files"`
+}
+
+type gitlabResponse struct {
+	Name        string                    `json:"name"`
+	WebUrl      string                    `json:"web_url"`
+	SshUrl      string                    `json:"ssh_url_to_repo"`
+	HttpUrl     string                    `json:"http_url_to_repo"`
+	DeployToken *gitlab.GitlabDeployToken `json:"deploy_token,omitempty"`
+}
+
+type indexData struct {
+	Session string
+}
+
+type errorResponse struct {
+	Kind    string `json:"kind"`
+	Message string `json:"message"`
+}
+
+// NewServer returns the server listening on address, preset may be nil.
+func NewServer(address, token string, preset *spring.Preset) (*Server, error) {
+	session := make([]byte, 16)
+	if _, err := rand.Read(session); err != nil {
+		return nil, err
+	}
+	server := &Server{address: address, token: token, session: hex.EncodeToString(session), preset: preset, mux: http.NewServeMux()}
+	server.mux.HandleFunc("/", server.handleIndex)
+	server.mux.HandleFunc("/api/defaults", server.handleDefaults)
+	server.mux.HandleFunc("/api/preview", server.handlePreview)
+	server.mux.HandleFunc("/api/zip", server.handleZip)
+	server.mux.HandleFunc("/api/gitlab", server.handleGitlab)
+	return server, nil
+}
+
+// Session returns the session token embedded in the web UI.
+func (server *Server) Session() string {
+	return server.session
+}
+
+func (server *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !server.allowHost(r.Host) {
+		writeJSON(w, http.StatusMisdirectedRequest, errorResponse{Message: "unknown host " + r.Host})
+		return
+	}
+	server.mux.ServeHTTP(w, r)
+}
+
+func (server *Server) handleIndex(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != "/" {
+		http.NotFound(w, r)
+		return
+	}
+	template, err := util.GetSpringTemplate(indexTemplate)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	index, err := util.ParseTemplate(indexData{Session: server.session}, indexTemplate, template)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Write([]byte(index))
+}
+
+func (server *Server) handleDefaults(w http.ResponseWriter, r *http.Request) {
+	if !allowMethod(w, r, http.MethodGet) {
+		return
+	}
+	manifest, err := server.defaultManifest()
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	locked := []string{}
+	if server.preset != nil {
+		locked = server.preset.Locked
+	}
+	writeJSON(w, http.StatusOK, defaultsResponse{
+		Manifest: manifest,
+		Locked:   locked,
+		Options: Options{
+			Language:    spring.Languages,
+			BuildTool:   []string{spring.Gradle, spring.Maven},
+			Packaging:   []string{spring.Jar, spring.War},
+			CIPipeline:  spring.CIPipelines,
+			JpaDatabase: spring.JpaDatabases(),
+		},
+	})
+}
+
+func (server *Server) handlePreview(w http.ResponseWriter, r *http.Request) {
+	server.generate(w, r, nil, func(manifest rlctl.Manifest, result *rlctl.Result) error {
+		response := previewResponse{Files: []previewFile{}}
+		for _, file := range result.Files {
+			content, err := ioutil.ReadFile(filepath.Join(result.ProjectRoot, filepath.FromSlash(file)))
+			if err != nil {
+				return err
+			}
+			preview := previewFile{Path: file, Size: int64(len(content))}
+			if len(content) <= maxPreviewSize && utf8.Valid(content) {
+				preview.Content = string(content)
+			}
+			response.Files = append(response.Files, preview)
+		}
+		writeJSON(w, http.StatusOK, response)
+		return nil
+	})
+}
+
+func (server *Server) handleZip(w http.ResponseWriter, r *http.Request) {
+	server.generate(w, r, nil, func(manifest rlctl.Manifest, result *rlctl.Result) error {
+		var archive bytes.Buffer
+		if err := util.Zip(result.ProjectRoot, &archive, manifest.Spring.Name); err != nil {
+			return err
+		}
+		w.Header().Set("Content-Type", "application/zip")
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", manifest.Spring.Name+".zip"))
+		_, err := archive.WriteTo(w)
+		return err
+	})
+}
+
+func (server *Server) handleGitlab(w http.ResponseWriter, r *http.Request) {
+	token := r.Header.Get("PRIVATE-TOKEN")
+	// The token of the server is used by the web UI of this process only, which is proven by the session token.
+	if token == "" && subtle.ConstantTimeCompare([]byte(r.Header.Get(SessionHeader)), []byte(server.session)) == 1 {
+		token = server.token
+	}
+	validate := func(manifest rlctl.Manifest) error {
+		if manifest.Gitlab == nil {
+			return util.NewValidationError("the manifest has no gitlab section")
+		}
+		if err := manifest.Gitlab.ProvisioningConfig.Validate(); err != nil {
+			return err
+		}
+		return util.ValidateRequired(token, "token")
+	}
+	server.generate(w, r, validate, func(manifest rlctl.Manifest, result *rlctl.Result) error {
+		project, deployToken, err := rlctl.Publish(r.Context(), gitlab.NewClient(token), manifest, result.ProjectRoot)
+		if err != nil {
+			return err
+		}
+		writeJSON(w, http.StatusCreated, gitlabResponse{
+			Name:        project.NameWithNamespace,
+			WebUrl:      project.WebUrl,
+			SshUrl:      project.RepoSshUrl,
+			HttpUrl:     project.RepoHttpUrl,
+			DeployToken: deployToken,
+		})
+		return nil
+	})
+}
+
+// generate generates the project of the posted manifest into a temporary directory, which is removed once respond
+// returned. validate, which may be nil, checks the manifest before generation.
+func (server *Server) generate(w http.ResponseWriter, r *http.Request, validate func(rlctl.Manifest) error, respond func(rlctl.Manifest, *rlctl.Result) error) {
+	if !allowMethod(w, r, http.MethodPost) {
+		return
+	}
+	// Browsers send cross-site form posts without a preflight, only the UI of this server posts JSON from its origin.
+	if mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type")); mediaType != "application/json" {
+		writeJSON(w, http.StatusUnsupportedMediaType, errorResponse{Message: "the content type must be application/json"})
+		return
+	}
+	if origin := r.Header.Get("Origin"); origin != "" && !sameOrigin(origin, r.Host) {
+		writeJSON(w, http.StatusForbidden, errorResponse{Message: "cross-origin requests are not allowed"})
+		return
+	}
+
+	manifest, err := server.defaultManifest()
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	defaults := manifest.Spring
+	decoder := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxRequestSize))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&manifest); err != nil {
+		writeError(w, util.NewValidationError("invalid manifest: %v", err))
+		return
+	}
+	// Like the settings of ~/.rlctl.yaml, which overlays cannot change, the Initializr instance is chosen by the server,
+	// a request must not make the server fetch arbitrary URLs.
+	manifest.Spring.InitializrUrl = defaults.InitializrUrl
+	if name := manifest.Spring.Name; name != "" && filepath.Base(name) != name {
+		writeError(w, util.NewValidationError("invalid name %s", manifest.Spring.Name))
+		return
+	}
+	if validate != nil {
+		if err := validate(manifest); err != nil {
+			writeError(w, err)
+			return
+		}
+	}
+
+	dir, err := ioutil.TempDir("", "rlctl-serve")
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	defer os.RemoveAll(dir)
+
+	// The output directory of the request is ignored, projects are generated into the temporary directory only.
+	options := rlctl.Options{OutputDirectory: filepath.Join(dir, "project"), Preset: server.preset}
+	result, err := rlctl.Generate(r.Context(), manifest, options)
+	if err == nil {
+		err = respond(manifest, result)
+	}
+	if err != nil && err != context.Canceled {
+		log.Printf("%s %s: %v\n", r.Method, r.URL.Path, err)
+		writeError(w, err)
+	}
+}
+
+// defaultManifest returns the defaults of the spring command with the settings of the preset.
+func (server *Server) defaultManifest() (rlctl.Manifest, error) {
+	manifest := rlctl.Manifest{Spring: spring.DefaultSpringProjectConfig()}
+	if server.preset != nil {
+		if err := server.preset.Apply(&manifest.Spring); err != nil {
+			return manifest, err
+		}
+	}
+	return manifest, nil
+}
+
+// allowHost reports whether host is the address of the server or a loopback host on its port, which rejects requests
+// of DNS rebinding sites. A server listening on all interfaces accepts any host on its port.
+func (server *Server) allowHost(host string) bool {
+	if host == server.address {
+		return true
+	}
+	listenHost, listenPort, err := net.SplitHostPort(server.address)
+	if err != nil {
+		return false
+	}
+	hostname, port, err := net.SplitHostPort(host)
+	if err != nil {
+		hostname, port = host, "80"
+	}
+	if port != listenPort {
+		return false
+	}
+	if ip := net.ParseIP(listenHost); listenHost == "" || ip != nil && ip.IsUnspecified() {
+		return true
+	}
+	ip := net.ParseIP(strings.Trim(hostname, "[]"))
+	return hostname == "localhost" || ip != nil && ip.IsLoopback()
+}
+
+// sameOrigin reports whether the origin of a request is the server at host.
+func sameOrigin(origin, host string) bool {
+	originUrl, err := url.Parse(origin)
+	return err == nil && (originUrl.Scheme == "http" || originUrl.Scheme == "https") && originUrl.Host == host
+}
+
+func allowMethod(w http.ResponseWriter, r *http.Request, method string) bool {
+	if r.Method != method {
+		w.Header().Set("Allow", method)
+		writeJSON(w, http.StatusMethodNotAllowed, errorResponse{Message: r.Method + " is not allowed"})
+		return false
+	}
+	return true
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	kind := util.KindOf(err)
+	status := http.StatusInternalServerError
+	switch kind {
+	case util.ValidationError:
+		status = http.StatusBadRequest
+	case util.NetworkError:
+		status = http.StatusBadGateway
+	}
+	writeJSON(w, status, errorResponse{Kind: kind.String(), Message: err.Error()})
+}
+
+func writeJSON(w http.ResponseWriter, status int, value interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(value); err != nil {
+		log.Printf("Unable to write the response: %v\n", err)
+	}
+}
@@ -0,0 +1,162 @@
+package server_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"github.com/rocketlaunchercloud/rlctl/project/server"
+	"github.com/rocketlaunchercloud/rlctl/project/spring"
+	"github.com/rocketlaunchercloud/rlctl/util"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+const (
+	address  = "localhost:8090"
+	manifest = `{"spring": {"name": "demo", "group": "com.example", "offline": true}}`
+)
+
+// TestMain caches a Gradle wrapper jar in a temporary cache directory, offline generation requires one and the tests
+// must not use the cache of the user.
+func TestMain(m *testing.M) {
+	cache, err := ioutil.TempDir("", "rlctl-cache")
+	if err != nil {
+		panic(err)
+	}
+	os.Setenv(util.CacheDirectoryEnv, cache)
+	if err = util.PutCached("gradle/wrapper/gradle-wrapper.jar", []byte("JAR")); err != nil {
+		panic(err)
+	}
+	code := m.Run()
+	os.RemoveAll(cache)
+	os.Exit(code)
+}
+
+func newServer(t *testing.T, token string) *server.Server {
+	handler, err := server.NewServer(address, token, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return handler
+}
+
+func newRequest(path, body string) *http.Request {
+	request := httptest.NewRequest(http.MethodPost, path, strings.NewReader(body))
+	request.Host = address
+	request.Header.Set("Content-Type", "application/json")
+	return request
+}
+
+func TestZip(t *testing.T) {
+	response := httptest.NewRecorder()
+	newServer(t, "").ServeHTTP(response, newRequest("/api/zip", manifest))
+	if response.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d %s", response.Code, response.Body)
+	}
+
+	data := response.Body.Bytes()
+	archive, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	found := false
+	for _, file := range archive.File {
+		if !strings.HasPrefix(file.Name, "demo/") {
+			t.Errorf("unexpected entry %s", file.Name)
+		}
+		if file.Name == "demo/gradlew" {
+			found = file.Mode()&0100 != 0
+		}
+	}
+	if !found {
+		t.Error("expected an executable demo/gradlew")
+	}
+}
+
+func TestInvalidRequests(t *testing.T) {
+	requests := map[string]*http.Request{
+		"unknown setting": newRequest("/api/preview", `{"spring": {"nme": "demo"}}`),
+		"invalid name":    newRequest("/api/zip", `{"spring": {"name": "../demo"}}`),
+		"missing gitlab":  newRequest("/api/gitlab", manifest),
+	}
+	for name, request := range requests {
+		response := httptest.NewRecorder()
+		newServer(t, "token").ServeHTTP(response, request)
+		if response.Code != http.StatusBadRequest {
+			t.Errorf("%s: expected 400, got %d", name, response.Code)
+		}
+	}
+}
+
+func TestInitializrUrl(t *testing.T) {
+	var trustedRequests, otherRequests int32
+	trusted := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&trustedRequests, 1)
+		http.NotFound(w, r)
+	}))
+	defer trusted.Close()
+	other := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&otherRequests, 1)
+		http.NotFound(w, r)
+	}))
+	defer other.Close()
+
+	preset := &spring.Preset{Name: "team", Spring: map[string]interface{}{"initializr-url": trusted.URL}}
+	handler, err := server.NewServer(address, "", preset)
+	if err != nil {
+		t.Fatal(err)
+	}
+	body := `{"spring": {"name": "demo", "group": "com.example", "initializr-url": "` + other.URL + `"}}`
+	handler.ServeHTTP(httptest.NewRecorder(), newRequest("/api/zip", body))
+	if otherRequests != 0 || trustedRequests == 0 {
+		t.Errorf("expected requests to the Initializr of the preset only, got %d and %d to the requested one",
+			trustedRequests, otherRequests)
+	}
+}
+
+func TestCrossSiteRequests(t *testing.T) {
+	handler := newServer(t, "token")
+	form := newRequest("/api/zip", manifest)
+	form.Header.Set("Content-Type", "text/plain")
+	crossOrigin := newRequest("/api/zip", manifest)
+	crossOrigin.Header.Set("Origin", "http://attacker.example.com")
+	rebound := newRequest("/api/zip", manifest)
+	rebound.Host = "attacker.example.com:8090"
+	// The stored token must not be used without the session token of the web UI.
+	storedToken := newRequest("/api/gitlab", `{"spring": {"name": "demo", "offline": true}, "gitlab": {"name": "demo", "namespace_id": 1}}`)
+	storedToken.Header.Set(server.SessionHeader, "guessed")
+
+	requests := map[string]struct {
+		request *http.Request
+		status  int
+	}{
+		"form post":    {form, http.StatusUnsupportedMediaType},
+		"cross origin": {crossOrigin, http.StatusForbidden},
+		"rebound host": {rebound, http.StatusMisdirectedRequest},
+		"stored token": {storedToken, http.StatusBadRequest},
+	}
+	for name, test := range requests {
+		response := httptest.NewRecorder()
+		handler.ServeHTTP(response, test.request)
+		if response.Code != test.status {
+			t.Errorf("%s: expected %d, got %d %s", name, test.status, response.Code, response.Body)
+		}
+	}
+}
+
+func TestIndexSession(t *testing.T) {
+	handler := newServer(t, "")
+	response := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodGet, "/", nil)
+	request.Host = "127.0.0.1:8090"
+	handler.ServeHTTP(response, request)
+	index, _ := ioutil.ReadAll(response.Body)
+	if response.Code != http.StatusOK || handler.Session() == "" ||
+		!strings.Contains(string(index), `<meta name="rlctl-session" content="`+handler.Session()+`">`) {
+		t.Errorf("expected the session token in the index, got %d %s", response.Code, index)
+	}
+}
@@ -17,46 +17,66 @@ var (
 	liquibaseConfigTemplate              = "config/liquibase-master.xml.tmpl"
 )
 
-func ParseAndSaveAppConfigTemplates(projectRoot string, templateData *SpringProjectConfig) {
+func ParseAndSaveAppConfigTemplates(projectRoot string, templateData *SpringProjectConfig) error {
 	configPath := path.Join(projectRoot, "config")
-	util.CreateDirIfNotExists(&configPath)
+	if err := os.MkdirAll(configPath, os.ModePerm); err != nil {
+		return err
+	}
 
 	if (*templateData).EnableLiquibase {
 		liquibaseDbChangeSetPath := path.Join(projectRoot, "src/main/resources/db")
-		util.CreateDirIfNotExists(&liquibaseDbChangeSetPath)
+		if err := os.MkdirAll(liquibaseDbChangeSetPath, os.ModePerm); err != nil {
+			return err
+		}
 
 		liquibaseTemplate, err := util.GetSpringTemplate(liquibaseConfigTemplate)
 		if err != nil {
-			util.LogMessageAndExit("Unable to copy Liquibase master.xml")
+			return fmt.Errorf("unable to copy Liquibase master.xml: %w", err)
 		}
 		liquibaseParsedTemplate, err := util.ParseTemplate(templateData, "master.xml", liquibaseTemplate)
 		if err != nil {
-			util.LogMessageAndExit("Unable to copy Liquibase master.xml")
+			return fmt.Errorf("unable to copy Liquibase master.xml: %w", err)
 		}
 
 		err = ioutil.WriteFile(path.Join(liquibaseDbChangeSetPath, "master.xml"), []byte(liquibaseParsedTemplate), os.ModePerm)
 		if err != nil {
-			util.LogMessageAndExit("Unable to copy Liquibase master.xml")
+			return fmt.Errorf("unable to copy Liquibase master.xml: %w", err)
 		}
 	}
 
-	compileTemplateAndSave(&configPath, &applicationConfigTemplate, templateData, "application.yml")
-	compileTemplateAndSave(&configPath, &applicationLocalConfigTemplate, templateData, "application-local.yml")
-	compileTemplateAndSave(&configPath, &applicationIntegrationConfigTemplate, templateData, "application-int.yml")
-	compileTemplateAndSave(&configPath, &applicationProdConfigTemplate, templateData, "application-prod.yml")
+	configFiles := []struct {
+		templatePath *string
+		fileName     string
+	}{
+		{&applicationConfigTemplate, "application.yml"},
+		{&applicationLocalConfigTemplate, "application-local.yml"},
+		{&applicationIntegrationConfigTemplate, "application-int.yml"},
+		{&applicationProdConfigTemplate, "application-prod.yml"},
+	}
+	for _, configFile := range configFiles {
+		if err := compileTemplateAndSave(&configPath, configFile.templatePath, templateData, configFile.fileName); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
-func compileTemplateAndSave(configPath, templatePath *string, templateData *SpringProjectConfig, fileName string) {
+func compileTemplateAndSave(configPath, templatePath *string, templateData *SpringProjectConfig, fileName string) error {
 	springTemplate, err := util.GetSpringTemplate(*templatePath)
-	util.LogAndExit(err, util.InvalidTemplate)
+	if err != nil {
+		return err
+	}
 
 	parsedTemplate, err := util.ParseTemplate(templateData, fileName, springTemplate)
-	util.LogAndExit(err, util.InvalidTemplate)
+	if err != nil {
+		return err
+	}
 
 	filePath := path.Join(*configPath, fileName)
 	err = ioutil.WriteFile(filePath, []byte(parsedTemplate), os.ModePerm)
 	if err != nil {
-		util.LogMessageAndExit(fmt.Sprintf("Unable to save %s", filePath))
+		return fmt.Errorf("unable to save %s: %w", filePath, err)
 	}
 	log.Printf("%s config file created successfully!", fileName)
+	return nil
 }
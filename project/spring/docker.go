@@ -6,11 +6,11 @@ import (
 )
 
 type Docker struct {
-	ExposedPort string
-	Image       string
-	Name        string
-	RegistryUrl string
-	BashImage   string
+	ExposedPort string `yaml:"container-port" json:"container-port"`
+	Image       string `yaml:"container-image" json:"container-image"`
+	Name        string `yaml:"-" json:"-"`
+	RegistryUrl string `yaml:"container-registry" json:"container-registry"`
+	BashImage   string `yaml:"container-bash-image" json:"container-bash-image"`
 }
 
 var (
@@ -29,16 +29,17 @@ var (
 
 func AddDockerFlagsToCommand(cmd *cobra.Command) {
 	cmd.Flags().StringP(containerPort, "", defaultDockerInstance.ExposedPort, "Docker exposed port")
-	cmd.Flags().StringP(containerImage, "", defaultDockerInstance.Image, "Docker exposed port")
+	cmd.Flags().StringP(containerImage, "", defaultDockerInstance.Image, "Docker base image")
 	cmd.Flags().StringP(containerRegistry, "", defaultDockerInstance.RegistryUrl, "Docker Registry URL")
-	cmd.Flags().StringP(bashImage, "", defaultDockerInstance.BashImage, "Docker Registry URL")
+	cmd.Flags().StringP(bashImage, "", defaultDockerInstance.BashImage, "Bash image used by the pipeline")
 }
 
-func CreateDockerInstanceFromCommandFlags(cmd *cobra.Command) Docker {
+func CreateDockerInstanceFromCommandFlags(cmd *cobra.Command) (Docker, error) {
+	flags := util.NewFlagReader(cmd)
 	var docker = Docker{}
-	docker.ExposedPort = util.GetValue(cmd, containerPort)
-	docker.Image = util.GetValue(cmd, containerImage)
-	docker.RegistryUrl = util.GetValue(cmd, containerRegistry)
-	docker.BashImage = util.GetValue(cmd, bashImage)
-	return docker
+	docker.ExposedPort = flags.Value(containerPort)
+	docker.Image = flags.Value(containerImage)
+	docker.RegistryUrl = flags.Value(containerRegistry)
+	docker.BashImage = flags.Value(bashImage)
+	return docker, flags.Err()
 }
@@ -0,0 +1,335 @@
+package spring
+
+import (
+	"bytes"
+	"github.com/rocketlaunchercloud/rlctl/util"
+	"io/ioutil"
+	"log"
+	"os"
+	"path"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Features AddFeature adds to existing projects.
+const (
+	KafkaFeature      = "kafka"
+	LiquibaseFeature  = "liquibase"
+	SonarFeature      = "sonar"
+	SecurityFeature   = "security"
+	OAuth2Feature     = "oauth2"
+	JacocoFeature     = "jacoco"
+	GitLabCIFeature   = "gitlab-ci"
+	KubernetesFeature = "k8s"
+	JibFeature        = "jib"
+)
+
+// Groups of template files, a feature recreates the missing files of the groups it owns.
+const (
+	configFiles     = "config"
+	pipelineFiles   = "pipeline"
+	kubernetesFiles = "kubernetes"
+)
+
+var fileGroups = map[string]func(projectRoot string, config *SpringProjectConfig) error{
+	configFiles: ParseAndSaveAppConfigTemplates,
+	pipelineFiles: func(projectRoot string, config *SpringProjectConfig) error {
+		if !config.EnableGitLabCI {
+			return nil
+		}
+		return ParseAndSaveCiCdFile(projectRoot, config)
+	},
+	kubernetesFiles: func(projectRoot string, config *SpringProjectConfig) error {
+		return SaveK8sTemplates(&projectRoot, config)
+	},
+}
+
+type feature struct {
+	enable func(config *SpringProjectConfig)
+	build  func(config *SpringProjectConfig) gradleChanges
+	owns   string
+}
+
+// gradleChanges are added to the build script, blocks are templates under spring/features added when present does
+// not match the script.
+type gradleChanges struct {
+	plugins      []gradlePlugin
+	dependencies []gradleDependency
+	repositories []gradleRepository
+	blocks       []gradleBlock
+}
+
+type gradleBlock struct {
+	template string
+	present  *regexp.Regexp
+}
+
+func implementation(notations ...string) []gradleDependency {
+	dependencies := make([]gradleDependency, len(notations))
+	for i, notation := range notations {
+		dependencies[i] = gradleDependency{configuration: "implementation", notation: notation}
+	}
+	return dependencies
+}
+
+var features = map[string]feature{
+	KafkaFeature: {
+		enable: func(config *SpringProjectConfig) { config.EnableKafka = true },
+		build: func(config *SpringProjectConfig) gradleChanges {
+			return gradleChanges{dependencies: implementation("org.springframework.kafka:spring-kafka")}
+		},
+	},
+	LiquibaseFeature: {
+		enable: func(config *SpringProjectConfig) { config.EnableLiquibase = true },
+		build: func(config *SpringProjectConfig) gradleChanges {
+			return gradleChanges{dependencies: implementation("org.liquibase:liquibase-core")}
+		},
+	},
+	SonarFeature: {
+		enable: func(config *SpringProjectConfig) { config.EnableSonar = true },
+		build: func(config *SpringProjectConfig) gradleChanges {
+			return gradleChanges{
+				plugins: []gradlePlugin{{id: "org.sonarqube", version: config.SonarQubeConfig.SonarVersion}},
+				blocks:  []gradleBlock{{template: "sonarqube", present: regexp.MustCompile(`(?m)^sonarqube\s*\{`)}},
+			}
+		},
+	},
+	SecurityFeature: {
+		enable: func(config *SpringProjectConfig) { config.EnableSecurity = true },
+		build: func(config *SpringProjectConfig) gradleChanges {
+			return gradleChanges{dependencies: implementation("org.springframework.boot:spring-boot-starter-security")}
+		},
+	},
+	OAuth2Feature: {
+		enable: func(config *SpringProjectConfig) { config.EnableSecurity, config.EnableOAuth2 = true, true },
+		build: func(config *SpringProjectConfig) gradleChanges {
+			return gradleChanges{dependencies: implementation(
+				"org.springframework.boot:spring-boot-starter-security",
+				"org.springframework.boot:spring-boot-starter-oauth2-client",
+				"org.springframework.boot:spring-boot-starter-oauth2-resource-server")}
+		},
+	},
+	JacocoFeature: {
+		enable: func(config *SpringProjectConfig) { config.EnableJacoco = true },
+		build: func(config *SpringProjectConfig) gradleChanges {
+			return gradleChanges{
+				plugins: []gradlePlugin{{id: "jacoco"}},
+				blocks:  []gradleBlock{{template: "jacoco", present: regexp.MustCompile(`jacocoTestReport\s*\{|JacocoReport>\s*\{`)}},
+			}
+		},
+	},
+	GitLabCIFeature: {
+		enable: func(config *SpringProjectConfig) {
+			config.EnableGitLabCI = true
+			if config.CIPipeline == "" {
+				config.CIPipeline = GitLabCIPipeline
+			}
+		},
+		owns: pipelineFiles,
+	},
+	KubernetesFeature: {
+		enable: func(config *SpringProjectConfig) {},
+		owns:   kubernetesFiles,
+	},
+	JibFeature: {
+		enable: func(config *SpringProjectConfig) { config.EnableJib = true },
+		build: func(config *SpringProjectConfig) gradleChanges {
+			return gradleChanges{
+				plugins: []gradlePlugin{{id: "com.google.cloud.tools.jib", version: JibVersion}},
+				blocks:  []gradleBlock{{template: "jib", present: regexp.MustCompile(`(?m)^jib\s*\{`)}},
+			}
+		},
+	},
+}
+
+// enabled reports whether the feature is enabled in config, i.e. enabling it changes nothing.
+func (feature feature) enabled(config *SpringProjectConfig) bool {
+	enabled := *config
+	feature.enable(&enabled)
+	return reflect.DeepEqual(enabled, *config)
+}
+
+// add appends other to the changes.
+func (changes gradleChanges) add(other gradleChanges) gradleChanges {
+	changes.plugins = append(changes.plugins, other.plugins...)
+	changes.repositories = append(changes.repositories, other.repositories...)
+	changes.dependencies = append(changes.dependencies, other.dependencies...)
+	changes.blocks = append(changes.blocks, other.blocks...)
+	return changes
+}
+
+// apply adds the changes to the build script, the blocks are rendered with config.
+func (changes gradleChanges) apply(script string, dsl gradleDSL, config *SpringProjectConfig) (string, error) {
+	script = addGradlePlugins(script, dsl, changes.plugins)
+	script = addGradleRepositories(script, dsl, changes.repositories)
+	script = addGradleDependencies(script, dsl, changes.dependencies)
+	for _, block := range changes.blocks {
+		if block.present.MatchString(script) {
+			continue
+		}
+		templatePath := "spring/features/" + block.template + ".gradle.tmpl"
+		if dsl == kotlinDSL {
+			templatePath = "spring/features/" + block.template + ".gradle.kts.tmpl"
+		}
+		template, err := util.GetSpringTemplate(templatePath)
+		if err != nil {
+			return "", err
+		}
+		parsed, err := util.ParseTemplate(config, block.template, template)
+		if err != nil {
+			return "", err
+		}
+		script = strings.TrimRight(script, "\n") + "\n\n" + reindent(parsed, dsl.indent(), scriptIndent(script, dsl))
+	}
+	return script, nil
+}
+
+// Features lists the features AddFeature supports.
+func Features() []string {
+	var names []string
+	for name := range features {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// FeatureResult lists the files AddFeature changed and the files it could not update, both relative to the project
+// root, and the values of YAML files which were kept although the feature changes them.
+type FeatureResult struct {
+	Changed   []string
+	Skipped   []string
+	Conflicts []string
+}
+
+// AddFeature adds the feature to the project generated into projectRoot. It reads the settings from ManifestFile,
+// adds plugins, dependencies and configuration blocks to the Gradle build script, merges the keys the feature adds
+// to YAML files like config/application.yml and .gitlab-ci.yml into the existing files, creates the files the
+// feature introduces and enables the feature in ManifestFile. Values changed by the user are kept and reported as
+// conflicts, running it again changes nothing.
+func AddFeature(projectRoot, name string) (*FeatureResult, error) {
+	added, found := features[name]
+	if !found {
+		return nil, util.NewValidationError("unknown feature %s, expected one of %s", name, strings.Join(Features(), ", "))
+	}
+	manifest, err := LoadManifestFile(projectRoot)
+	if err != nil {
+		return nil, err
+	}
+	before := manifest.Spring
+	after := manifest.Spring
+	added.enable(&after)
+
+	result := &FeatureResult{}
+	if added.build != nil {
+		if err = addToBuild(projectRoot, &after, added.build(&after), result); err != nil {
+			return nil, err
+		}
+	}
+	for group, save := range fileGroups {
+		if err = updateFiles(projectRoot, &before, &after, save, group == added.owns, result); err != nil {
+			return nil, err
+		}
+	}
+
+	if !reflect.DeepEqual(before, after) {
+		manifest.Spring = after
+		if err = manifest.save(projectRoot); err != nil {
+			return nil, err
+		}
+		result.Changed = append(result.Changed, ManifestFile)
+	}
+	sort.Strings(result.Changed)
+	sort.Strings(result.Skipped)
+	return result, nil
+}
+
+// addToBuild applies the changes to build.gradle or build.gradle.kts. Maven builds are left to the user.
+func addToBuild(projectRoot string, config *SpringProjectConfig, changes gradleChanges, result *FeatureResult) error {
+	if config.BuildTool != Gradle {
+		result.Skipped = append(result.Skipped, "pom.xml")
+		return nil
+	}
+	buildFile := gradleBuildFile(projectRoot)
+	file := path.Join(projectRoot, buildFile)
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return util.NewFileSystemError(file, err)
+	}
+
+	script, err := changes.apply(string(data), dslOf(buildFile), config)
+	if err != nil {
+		return err
+	}
+	if script == string(data) {
+		return nil
+	}
+	if err = ioutil.WriteFile(file, []byte(script), os.ModePerm); err != nil {
+		return util.NewFileSystemError(file, err)
+	}
+	result.Changed = append(result.Changed, buildFile)
+	return nil
+}
+
+// updateFiles renders the group with the settings before and after adding the feature. Files the feature introduces
+// are created, or merged if they exist, YAML files the feature changes are merged and other changed files are
+// skipped. Missing files of owned groups are recreated.
+func updateFiles(projectRoot string, before, after *SpringProjectConfig, save func(string, *SpringProjectConfig) error, owned bool, result *FeatureResult) error {
+	previous, err := renderFiles(before, save)
+	if err != nil {
+		return err
+	}
+	current, err := renderFiles(after, save)
+	if err != nil {
+		return err
+	}
+
+	for name, content := range current {
+		introduced := !bytes.Equal(previous[name], content)
+		file := filepath.Join(projectRoot, filepath.FromSlash(name))
+		existing, err := ioutil.ReadFile(file)
+		switch {
+		case os.IsNotExist(err) && (introduced || owned):
+			if err = os.MkdirAll(filepath.Dir(file), os.ModePerm); err != nil {
+				return util.NewFileSystemError(file, err)
+			}
+			if err = ioutil.WriteFile(file, content, os.ModePerm); err != nil {
+				return util.NewFileSystemError(file, err)
+			}
+			result.Changed = append(result.Changed, name)
+			continue
+		case os.IsNotExist(err) || !introduced:
+			continue
+		case err != nil:
+			return util.NewFileSystemError(file, err)
+		}
+
+		ext := filepath.Ext(name)
+		if ext != ".yml" && ext != ".yaml" {
+			if !bytes.Equal(existing, content) {
+				result.Skipped = append(result.Skipped, name)
+			}
+			continue
+		}
+		merged, conflicts, err := util.MergeYaml(existing, previous[name], content)
+		for _, conflict := range conflicts {
+			result.Conflicts = append(result.Conflicts, name+": "+conflict.String())
+		}
+		if err != nil {
+			log.Printf("Unable to merge %s: %v\n", name, err)
+			result.Skipped = append(result.Skipped, name)
+			continue
+		}
+		if merged == nil {
+			continue
+		}
+		if err = ioutil.WriteFile(file, merged, os.ModePerm); err != nil {
+			return util.NewFileSystemError(file, err)
+		}
+		result.Changed = append(result.Changed, name)
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+package spring_test
+
+import (
+	"context"
+	"github.com/rocketlaunchercloud/rlctl/project/spring"
+	"github.com/rocketlaunchercloud/rlctl/util"
+	"io/ioutil"
+	"os"
+	"path"
+	"strings"
+	"testing"
+)
+
+func TestAddFeature(t *testing.T) {
+	root, err := ioutil.TempDir("", "rlctl")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+
+	config := spring.DefaultSpringProjectConfig()
+	config.Name, config.Group, config.Offline = "orders", "com.example", true
+	config.OutputDirectory = path.Join(root, "orders")
+	if _, err = spring.Generate(context.Background(), &config, spring.FailPolicy, nil); err != nil {
+		t.Fatal(err)
+	}
+	read := func(file string) string {
+		data, err := ioutil.ReadFile(path.Join(config.OutputDirectory, file))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return string(data)
+	}
+
+	// User edits must survive
+	application := strings.Replace(read("config/application.yml"), "port: 8080", "port: 9090", 1)
+	if err = ioutil.WriteFile(path.Join(config.OutputDirectory, "config/application.yml"), []byte(application), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, feature := range []string{spring.KafkaFeature, spring.SecurityFeature, spring.SonarFeature} {
+		if _, err = spring.AddFeature(config.OutputDirectory, feature); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	build := read("build.gradle")
+	for _, expected := range []string{"implementation 'org.springframework.kafka:spring-kafka'",
+		"implementation 'org.springframework.boot:spring-boot-starter-security'", "id 'org.sonarqube' version '2.8'", "\nsonarqube {"} {
+		if !strings.Contains(build, expected) {
+			t.Errorf("build.gradle misses %s:\n%s", expected, build)
+		}
+	}
+	if application = read("config/application.yml"); !strings.Contains(application, "security:") || !strings.Contains(application, "port: 9090") {
+		t.Errorf("unexpected config/application.yml:\n%s", application)
+	}
+	if pipeline := read(".gitlab-ci.yml"); !strings.Contains(pipeline, "- check\n  - sonar\n  - pack") ||
+		!strings.Contains(pipeline, "# Build project") || !strings.Contains(pipeline, "SonarQube Check:") {
+		t.Errorf("the sonar stage is not added to .gitlab-ci.yml:\n%s", pipeline)
+	}
+	manifest, err := spring.LoadManifestFile(config.OutputDirectory)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !manifest.Spring.EnableKafka || !manifest.Spring.EnableSecurity || !manifest.Spring.EnableSonar {
+		t.Errorf("the features are not enabled in %s: %+v", spring.ManifestFile, manifest.Spring)
+	}
+
+	result, err := spring.AddFeature(config.OutputDirectory, spring.SonarFeature)
+	if err != nil || len(result.Changed) != 0 {
+		t.Errorf("adding a feature twice must change nothing: %+v %v", result, err)
+	}
+	if _, err = spring.AddFeature(config.OutputDirectory, "graphql"); util.KindOf(err) != util.ValidationError {
+		t.Errorf("expected a validation error, got %v", err)
+	}
+}
@@ -0,0 +1,375 @@
+package spring
+
+import (
+	"context"
+	"fmt"
+	"github.com/rocketlaunchercloud/rlctl/project/git"
+	"github.com/rocketlaunchercloud/rlctl/util"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// Policies applied when the output directory already holds files.
+const (
+	FailPolicy  = "fail"
+	ForcePolicy = "force"
+	MergePolicy = "merge"
+)
+
+// Generation steps reported to the progress listener.
+const (
+	InitializrStep        = "initializr"
+	BuildStep             = "build"
+	ApplicationConfigStep = "application-config"
+	PipelineStep          = "pipeline"
+	GitIgnoreStep         = "gitignore"
+	SonarStep             = "sonar"
+	KubernetesStep        = "kubernetes"
+	ManifestStep          = "manifest"
+	CommitStep            = "commit"
+)
+
+const (
+	stagingDirectoryPrefix = ".rlctl-staging-"
+	backupDirectoryPrefix  = ".rlctl-backup-"
+)
+
+// ProgressFunc is notified before a generation step starts.
+type ProgressFunc func(step string)
+
+// GeneratedProject is the outcome of Generate, Files are relative to Root. Conflicts are the values of existing YAML
+// files the merge policy kept although the templates changed them.
+type GeneratedProject struct {
+	Root      string
+	Files     []string
+	Conflicts []string
+}
+
+type generationStep struct {
+	name string
+	run  func(ctx context.Context, config *SpringProjectConfig) error
+}
+
+var generationSteps = []generationStep{
+	{InitializrStep, func(ctx context.Context, config *SpringProjectConfig) error {
+		_, err := GenerateSpringProject(ctx, config)
+		return err
+	}},
+	{BuildStep, func(ctx context.Context, config *SpringProjectConfig) error {
+		return composeBuild(config)
+	}},
+	{ApplicationConfigStep, func(ctx context.Context, config *SpringProjectConfig) error {
+		return ParseAndSaveAppConfigTemplates(config.OutputDirectory, config)
+	}},
+	{PipelineStep, func(ctx context.Context, config *SpringProjectConfig) error {
+		if !config.EnableGitLabCI {
+			return nil
+		}
+		return ParseAndSaveCiCdFile(config.OutputDirectory, config)
+	}},
+	{GitIgnoreStep, func(ctx context.Context, config *SpringProjectConfig) error {
+		if err := git.ParseAndSaveGitIgnore(config.OutputDirectory); err != nil {
+			return fmt.Errorf("unable to copy .gitignore: %w", err)
+		}
+		return nil
+	}},
+	{SonarStep, func(ctx context.Context, config *SpringProjectConfig) error {
+		message, err := ParseAndSaveSonarQubeFile(config.OutputDirectory, config)
+		if err != nil {
+			return fmt.Errorf("unable to copy sonar-project.properties: %w", err)
+		}
+		log.Println(message)
+		return nil
+	}},
+	{KubernetesStep, func(ctx context.Context, config *SpringProjectConfig) error {
+		return SaveK8sTemplates(&config.OutputDirectory, config)
+	}},
+	{ManifestStep, func(ctx context.Context, config *SpringProjectConfig) error {
+		return saveManifestFile(config.OutputDirectory, *config)
+	}},
+}
+
+// Generate runs every generation step in a staging directory next to the output directory and moves the result into
+// place only when all steps succeeded, a failed or cancelled run leaves the output directory untouched. progress may
+// be nil.
+func Generate(ctx context.Context, config *SpringProjectConfig, policy string, progress ProgressFunc) (*GeneratedProject, error) {
+	if progress == nil {
+		progress = func(string) {}
+	}
+
+	// Absolute, the staging and backup directories are created in the parent, which would be the target itself for ".".
+	target, err := filepath.Abs(config.OutputDirectory)
+	if err != nil {
+		return nil, err
+	}
+	switch policy {
+	case FailPolicy, "":
+		if err := util.ValidateOutputDirectory(target); err != nil {
+			return nil, err
+		}
+	case ForcePolicy, MergePolicy:
+	default:
+		return nil, util.NewValidationError("unsupported policy %s", policy)
+	}
+	switch config.Language {
+	case Java, Kotlin, Groovy, "":
+	default:
+		return nil, util.NewValidationError("unsupported language %s, expected one of %s", config.Language, strings.Join(Languages, ", "))
+	}
+
+	parent := filepath.Dir(target)
+	if err := os.MkdirAll(parent, os.ModePerm); err != nil {
+		return nil, err
+	}
+	// The staging directory is a sibling of the target so the final rename stays on the same file system.
+	stagingDir, err := ioutil.TempDir(parent, stagingDirectoryPrefix)
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(stagingDir)
+
+	staged := *config
+	staged.OutputDirectory = stagingDir
+	for _, step := range generationSteps {
+		if err = ctx.Err(); err != nil {
+			return nil, err
+		}
+		progress(step.name)
+		if err = step.run(ctx, &staged); err != nil {
+			return nil, err
+		}
+	}
+
+	files, err := listFiles(stagingDir)
+	if err != nil {
+		return nil, err
+	}
+	// Copied before merging, the next merge compares with the files as the templates generated them.
+	baseFiles, err := saveMergeBase(stagingDir, files)
+	if err != nil {
+		return nil, err
+	}
+	files = append(files, baseFiles...)
+	sort.Strings(files)
+
+	if err = ctx.Err(); err != nil {
+		return nil, err
+	}
+	var conflicts []string
+	if policy == MergePolicy {
+		if conflicts, err = mergeYamlFiles(stagingDir, target, files); err != nil {
+			return nil, err
+		}
+	}
+	progress(CommitStep)
+	if err = commit(stagingDir, target, policy); err != nil {
+		return nil, err
+	}
+	return &GeneratedProject{Root: target, Files: files, Conflicts: conflicts}, nil
+}
+
+// composeBuild adds the enabled features and the linters of the language to the build script Initializr created and
+// adds the Dockerfile.
+func composeBuild(config *SpringProjectConfig) error {
+	if config.BuildTool != Gradle {
+		return nil
+	}
+
+	projectRoot := config.OutputDirectory
+	if err := ComposeGradleBuild(projectRoot, config); err != nil {
+		return err
+	}
+	if err := CreateLintConfig(projectRoot, config); err != nil {
+		return err
+	}
+	return CreateGradleDockerfile(&projectRoot, config)
+}
+
+// mergeYamlFiles merges the existing YAML files of the target into the staged files, so merging keeps the changes
+// made to them. The copies in MergeBaseDirectory of the target are the base of the merge. Projects generated without
+// copies use the templates rendered with the settings of their ManifestFile, which reports template upgrades as
+// conflicts.
+func mergeYamlFiles(stagingDir, target string, files []string) ([]string, error) {
+	var rendered map[string][]byte
+	renderedBase := func(file string) ([]byte, error) {
+		if rendered != nil {
+			return rendered[file], nil
+		}
+		rendered = map[string][]byte{}
+		if exists, _ := util.Exists(filepath.Join(target, ManifestFile)); !exists {
+			return nil, nil
+		}
+		manifest, err := LoadManifestFile(target)
+		if err != nil {
+			return nil, err
+		}
+		for _, save := range fileGroups {
+			groupFiles, err := renderFiles(&manifest.Spring, save)
+			if err != nil {
+				return nil, err
+			}
+			for name, content := range groupFiles {
+				rendered[name] = content
+			}
+		}
+		return rendered[file], nil
+	}
+
+	var conflicts []string
+	for _, file := range files {
+		// The manifest describes the new settings and the merge base is replaced
+		if !isMergedFile(file) {
+			continue
+		}
+		existing, err := ioutil.ReadFile(filepath.Join(target, filepath.FromSlash(file)))
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		staged := filepath.Join(stagingDir, filepath.FromSlash(file))
+		generated, err := ioutil.ReadFile(staged)
+		if err != nil {
+			return nil, err
+		}
+
+		base, err := ioutil.ReadFile(filepath.Join(target, MergeBaseDirectory, filepath.FromSlash(file)))
+		if os.IsNotExist(err) {
+			base, err = renderedBase(file)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		merged, fileConflicts, err := util.MergeYaml(existing, base, generated)
+		if err != nil {
+			log.Printf("Unable to merge %s, it is replaced: %v\n", file, err)
+			continue
+		}
+		for _, conflict := range fileConflicts {
+			conflicts = append(conflicts, file+": "+conflict.String())
+		}
+		if merged == nil {
+			merged = existing
+		}
+		if err = ioutil.WriteFile(staged, merged, os.ModePerm); err != nil {
+			return nil, err
+		}
+	}
+	return conflicts, nil
+}
+
+// listFiles returns the slash separated paths of the regular files under root relative to root.
+func listFiles(root string) ([]string, error) {
+	var files []string
+	err := filepath.Walk(root, func(file string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		relative, err := filepath.Rel(root, file)
+		if err != nil {
+			return err
+		}
+		files = append(files, filepath.ToSlash(relative))
+		return nil
+	})
+	return files, err
+}
+
+// commit moves the staged project into the target according to the policy.
+func commit(stagingDir, target, policy string) error {
+	// ioutil.TempDir creates the staging directory accessible by its owner only, the project is not.
+	if err := os.Chmod(stagingDir, 0755); err != nil {
+		return err
+	}
+	if exists, _ := util.Exists(target); !exists {
+		return os.Rename(stagingDir, target)
+	}
+	if policy == ForcePolicy {
+		return replaceEntries(stagingDir, target)
+	}
+	return moveInto(stagingDir, target)
+}
+
+// replaceEntries replaces the entries of the target with the staged entries. Repository metadata is kept, forcing
+// regenerates the working tree only. The replaced entries are moved into a backup directory next to the target, which
+// is restored if the staged entries cannot be moved in and removed otherwise.
+func replaceEntries(stagingDir, target string) error {
+	backupDir, err := ioutil.TempDir(filepath.Dir(target), backupDirectoryPrefix)
+	if err != nil {
+		return err
+	}
+	files, err := ioutil.ReadDir(target)
+	if err == nil {
+		for _, file := range files {
+			if file.Name() == ".git" {
+				continue
+			}
+			if err = os.Rename(filepath.Join(target, file.Name()), filepath.Join(backupDir, file.Name())); err != nil {
+				break
+			}
+		}
+	}
+	if err == nil {
+		err = moveInto(stagingDir, target)
+	}
+	if err != nil {
+		if restoreErr := restoreEntries(backupDir, target); restoreErr != nil {
+			return fmt.Errorf("%w, the replaced files are kept in %s: %v", err, backupDir, restoreErr)
+		}
+		os.RemoveAll(backupDir)
+		return err
+	}
+	return os.RemoveAll(backupDir)
+}
+
+// restoreEntries replaces the entries of the target except .git with the entries of the backup directory.
+func restoreEntries(backupDir, target string) error {
+	files, err := ioutil.ReadDir(target)
+	if err != nil {
+		return err
+	}
+	for _, file := range files {
+		if file.Name() == ".git" {
+			continue
+		}
+		if err = os.RemoveAll(filepath.Join(target, file.Name())); err != nil {
+			return err
+		}
+	}
+	return moveInto(backupDir, target)
+}
+
+// moveInto moves the entries of src into dst, directories existing in both are merged and files are replaced.
+func moveInto(src, dst string) error {
+	files, err := ioutil.ReadDir(src)
+	if err != nil {
+		return err
+	}
+	for _, file := range files {
+		from := filepath.Join(src, file.Name())
+		to := filepath.Join(dst, file.Name())
+
+		existing, err := os.Stat(to)
+		if err == nil && existing.IsDir() && file.IsDir() {
+			if err = moveInto(from, to); err != nil {
+				return err
+			}
+			continue
+		}
+		if err == nil {
+			if err = os.RemoveAll(to); err != nil {
+				return err
+			}
+		}
+		if err = os.Rename(from, to); err != nil {
+			return err
+		}
+	}
+	return nil
+}
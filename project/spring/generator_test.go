@@ -0,0 +1,231 @@
+package spring_test
+
+import (
+	"context"
+	"github.com/rocketlaunchercloud/rlctl/project/spring"
+	"github.com/rocketlaunchercloud/rlctl/util"
+	"io/ioutil"
+	"os"
+	"path"
+	"strings"
+	"testing"
+)
+
+func TestGenerateRollback(t *testing.T) {
+	root, err := ioutil.TempDir("", "rlctl")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+
+	var springProjectConfig spring.SpringProjectConfig
+	springProjectConfig.Name = "demo"
+	springProjectConfig.Group = "com.example"
+	springProjectConfig.BuildTool = spring.Gradle
+	springProjectConfig.Language = spring.Java
+	springProjectConfig.Offline = true
+	springProjectConfig.EnableGitLabCI = true
+	springProjectConfig.CIPipeline = "unknown"
+	springProjectConfig.OutputDirectory = path.Join(root, "demo")
+
+	if _, err = spring.Generate(context.Background(), &springProjectConfig, spring.FailPolicy, nil); err == nil {
+		t.Fatal("expected unsupported CI pipeline error")
+	}
+	files, err := ioutil.ReadDir(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 0 {
+		t.Fatalf("expected nothing left behind, got %d entries", len(files))
+	}
+
+	springProjectConfig.CIPipeline = spring.GitLabCIPipeline
+	if _, err = spring.Generate(context.Background(), &springProjectConfig, spring.FailPolicy, nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = spring.Generate(context.Background(), &springProjectConfig, spring.FailPolicy, nil); err == nil {
+		t.Fatal("expected existing output directory error")
+	}
+
+	extra := path.Join(springProjectConfig.OutputDirectory, "extra")
+	if err = ioutil.WriteFile(extra, []byte("extra"), os.ModePerm); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = spring.Generate(context.Background(), &springProjectConfig, spring.MergePolicy, nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = os.Stat(extra); err != nil {
+		t.Fatal("merge should keep existing files")
+	}
+	if _, err = spring.Generate(context.Background(), &springProjectConfig, spring.ForcePolicy, nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = os.Stat(extra); !os.IsNotExist(err) {
+		t.Fatal("force should remove existing files")
+	}
+	if files, _ = ioutil.ReadDir(root); len(files) != 1 || files[0].Mode().Perm() != 0755 {
+		t.Fatalf("expected the project directory with mode 0755 only, got %v", files)
+	}
+}
+
+func TestGenerateLanguage(t *testing.T) {
+	root, err := ioutil.TempDir("", "rlctl")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+
+	tests := []struct {
+		language string
+		expected map[string][]string
+	}{
+		{
+			language: spring.Java,
+			expected: map[string][]string{
+				"build.gradle":             {"id 'jacoco'", "\nsonarqube {"},
+				".gitlab-ci.yml":           {"./gradlew check --build-cache"},
+				"sonar-project.properties": {"sonar.java.source=11", "**/*Dto.java"},
+			},
+		},
+		{
+			language: spring.Kotlin,
+			expected: map[string][]string{
+				"build.gradle.kts": {"id(\"io.gitlab.arturbosch.detekt\")", "id(\"org.jlleitschuh.gradle.ktlint\")", "id(\"jacoco\")",
+					"\ndetekt {\n\tbuildUponDefaultConfig = true", "sonar.kotlin.detekt.reportPaths"},
+				".gitlab-ci.yml":           {"./gradlew detekt ktlintCheck check --build-cache"},
+				"sonar-project.properties": {"sonar.kotlin.ktlint.reportPaths=", "**/*Dto.kt"},
+				".editorconfig":            {"indent_style = tab"},
+				"detekt.yml":               {"SpreadOperator"},
+			},
+		},
+		{
+			language: spring.Groovy,
+			expected: map[string][]string{
+				"build.gradle": {"id 'groovy'", "implementation 'org.codehaus.groovy:groovy'", "id 'codenarc'",
+					"configFile = file('codenarc.xml')", "sonar.groovy.codenarc.reportPath"},
+				"src/main/groovy/com/example/orders/OrdersApplication.groovy": {"class OrdersApplication {"},
+				".gitlab-ci.yml":           {"./gradlew codenarcMain codenarcTest check --build-cache"},
+				"sonar-project.properties": {"sonar.groovy.binaries=", "**/*Dto.groovy"},
+				"codenarc.xml":             {"rulesets/basic.xml"},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		config := spring.DefaultSpringProjectConfig()
+		config.Name, config.Group, config.Offline = "orders", "com.example", true
+		config.Language, config.EnableSonar = test.language, true
+		config.OutputDirectory = path.Join(root, test.language)
+		if _, err = spring.Generate(context.Background(), &config, spring.FailPolicy, nil); err != nil {
+			t.Fatal(err)
+		}
+		for file, expected := range test.expected {
+			data, err := ioutil.ReadFile(path.Join(config.OutputDirectory, file))
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, content := range expected {
+				if !strings.Contains(string(data), content) {
+					t.Errorf("%s of the %s project misses %q:\n%s", file, test.language, content, data)
+				}
+			}
+		}
+	}
+
+	config := spring.DefaultSpringProjectConfig()
+	config.Name, config.Group, config.Offline, config.Language = "orders", "com.example", true, "scala"
+	config.OutputDirectory = path.Join(root, config.Language)
+	if _, err = spring.Generate(context.Background(), &config, spring.FailPolicy, nil); util.KindOf(err) != util.ValidationError {
+		t.Errorf("expected a validation error, got %v", err)
+	}
+}
+
+func TestGenerateMergeUpgrade(t *testing.T) {
+	root, err := ioutil.TempDir("", "rlctl")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+
+	config := spring.DefaultSpringProjectConfig()
+	config.Name, config.Group, config.Offline = "orders", "com.example", true
+	config.OutputDirectory = path.Join(root, "orders")
+	if _, err = spring.Generate(context.Background(), &config, spring.FailPolicy, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	// The project was generated by templates with apps/v1beta1 and the team changed the replicas since.
+	file := path.Join(config.OutputDirectory, spring.K8SProdTemplate)
+	base := path.Join(config.OutputDirectory, spring.MergeBaseDirectory, spring.K8SProdTemplate)
+	generated, err := ioutil.ReadFile(base)
+	if err != nil {
+		t.Fatal(err)
+	}
+	previous := strings.Replace(string(generated), "apiVersion: apps/v1\n", "apiVersion: apps/v1beta1\n", 1)
+	if err = ioutil.WriteFile(base, []byte(previous), 0644); err != nil {
+		t.Fatal(err)
+	}
+	changed := strings.Replace(previous, "replicas: 3", "replicas: 5", 1)
+	if err = ioutil.WriteFile(file, []byte(changed), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	project, err := spring.Generate(context.Background(), &config, spring.MergePolicy, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	merged, err := ioutil.ReadFile(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(merged), "apiVersion: apps/v1\n") || !strings.Contains(string(merged), "replicas: 5") {
+		t.Errorf("expected the upgraded apiVersion and the changed replicas:\n%s", merged)
+	}
+	expected := spring.K8SProdTemplate + ": Deployment/orders: spec.replicas (line 11): kept 5, the template has 3"
+	if len(project.Conflicts) != 1 || project.Conflicts[0] != expected {
+		t.Errorf("unexpected conflicts %v", project.Conflicts)
+	}
+	if stored, _ := ioutil.ReadFile(base); string(stored) != string(generated) {
+		t.Errorf("the merge base must be the generated file:\n%s", stored)
+	}
+}
+
+func TestGenerateForceWorkingDirectory(t *testing.T) {
+	root, err := ioutil.TempDir("", "rlctl")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+	workingDir, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Chdir(workingDir)
+
+	project := path.Join(root, "orders")
+	if err = os.MkdirAll(project, 0755); err != nil {
+		t.Fatal(err)
+	}
+	extra := path.Join(project, "extra")
+	if err = ioutil.WriteFile(extra, []byte("extra"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err = os.Chdir(project); err != nil {
+		t.Fatal(err)
+	}
+
+	config := spring.DefaultSpringProjectConfig()
+	config.Name, config.Group, config.Offline, config.OutputDirectory = "orders", "com.example", true, "."
+	if _, err = spring.Generate(context.Background(), &config, spring.ForcePolicy, nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = os.Stat(path.Join(project, spring.ManifestFile)); err != nil {
+		t.Error("expected the project in the working directory:", err)
+	}
+	if _, err = os.Stat(extra); !os.IsNotExist(err) {
+		t.Error("force should remove existing files")
+	}
+	if files, _ := ioutil.ReadDir(root); len(files) != 1 {
+		t.Errorf("expected no staging or backup directory next to the project, got %v", files)
+	}
+}
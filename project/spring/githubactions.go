@@ -0,0 +1,80 @@
+package spring
+
+import (
+	"fmt"
+	"github.com/rocketlaunchercloud/rlctl/util"
+	"io/ioutil"
+	"log"
+	"os"
+	"path"
+	"strings"
+)
+
+var (
+	githubActionsTemplate     = "buildpipeline/github-actions.yml"
+	githubWorkflowsPath       = ".github/workflows"
+	githubActionsWorkflowFile = "ci.yml"
+
+	// GitLab CI except keywords and the GitHub Actions events they correspond to.
+	githubActionsEvents = map[string]string{
+		"schedules":      "schedule",
+		"pushes":         "push",
+		"merge_requests": "pull_request",
+		"web":            "workflow_dispatch",
+		"api":            "repository_dispatch",
+		"triggers":       "repository_dispatch",
+	}
+)
+
+func parseAndSaveGitHubActionsFile(projectRoot string, templateData *SpringProjectConfig) error {
+	data := pipelineTemplateData{
+		SpringProjectConfig: templateData,
+		Pipeline:            NewPipeline(templateData),
+	}
+	data.Condition = githubActionsCondition(data.Pipeline.Excepts)
+
+	templateStr, err := util.GetSpringTemplate(githubActionsTemplate)
+	if err != nil {
+		return err
+	}
+	parsedTemplate, err := util.ParseTemplate(data, githubActionsWorkflowFile, templateStr)
+	if err != nil {
+		return err
+	}
+
+	workflowsPath := path.Join(projectRoot, githubWorkflowsPath)
+	if err = os.MkdirAll(workflowsPath, os.ModePerm); err != nil {
+		return err
+	}
+
+	filePath := path.Join(workflowsPath, githubActionsWorkflowFile)
+	err = ioutil.WriteFile(filePath, []byte(parsedTemplate), os.ModePerm)
+	if err != nil {
+		return fmt.Errorf("unable to save %s: %w", filePath, err)
+	}
+	log.Printf("%s workflow file created successfully!", githubActionsWorkflowFile)
+	return nil
+}
+
+// githubActionsCondition translates GitLab CI excepts into an `if` expression which skips the job for the same
+// events or refs. The workflow wraps it in ${{ }}, a plain scalar starting with ! would be a YAML tag.
+func githubActionsCondition(excepts []string) string {
+	var conditions []string
+	for _, except := range excepts {
+		switch except {
+		case "tags":
+			conditions = append(conditions, "!startsWith(github.ref, 'refs/tags/')")
+		case "branches":
+			conditions = append(conditions, "!startsWith(github.ref, 'refs/heads/')")
+		default:
+			if event, ok := githubActionsEvents[except]; ok {
+				conditions = append(conditions, fmt.Sprintf("github.event_name != '%s'", event))
+			} else {
+				// Quotes are escaped by doubling them in the string literals of expressions
+				branch := strings.Replace(except, "'", "''", -1)
+				conditions = append(conditions, fmt.Sprintf("github.ref != 'refs/heads/%s'", branch))
+			}
+		}
+	}
+	return strings.Join(conditions, " && ")
+}
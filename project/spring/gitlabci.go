@@ -7,21 +7,22 @@ import (
 	"io/ioutil"
 	"log"
 	"os"
-	"os/exec"
 	"path"
 )
 
 type GitLabCI struct {
-	Tags                    []string
-	Excepts                 []string
-	K8SDeployStagingEnvTags []string
-	K8SDeployProdEnvTags    []string
-	Deployer                string
-	K8SDevNamespace         string
-	K8SProdNamespace        string
-	K8SDevCluster           string
-	K8SProdCluster          string
-	SonarQubeScannerImage   string
+	Tags                    []string `yaml:"gitlab-ci-tags" json:"gitlab-ci-tags"`
+	Excepts                 []string `yaml:"gitlab-ci-except" json:"gitlab-ci-except"`
+	K8SDeployStagingEnvTags []string `yaml:"gitlab-ci-k8s-deploy-staging-tags" json:"gitlab-ci-k8s-deploy-staging-tags"`
+	K8SDeployProdEnvTags    []string `yaml:"gitlab-ci-k8s-deploy-prod-tags" json:"gitlab-ci-k8s-deploy-prod-tags"`
+	Deployer                string   `yaml:"gitlab-ci-deployer" json:"gitlab-ci-deployer"`
+	K8SDevNamespace         string   `yaml:"gitlab-ci-k8s-staging-namespace" json:"gitlab-ci-k8s-staging-namespace"`
+	K8SProdNamespace        string   `yaml:"gitlab-ci-k8s-prod-namespace" json:"gitlab-ci-k8s-prod-namespace"`
+	K8SDevCluster           string   `yaml:"gitlab-ci-k8s-staging-cluster" json:"gitlab-ci-k8s-staging-cluster"`
+	K8SProdCluster          string   `yaml:"gitlab-ci-k8s-prod-cluster" json:"gitlab-ci-k8s-prod-cluster"`
+	StagingUrl              string   `yaml:"gitlab-ci-staging-url" json:"gitlab-ci-staging-url"`
+	ProdUrl                 string   `yaml:"gitlab-ci-prod-url" json:"gitlab-ci-prod-url"`
+	SonarQubeScannerImage   string   `yaml:"gitlab-ci-sonar-scanner-image" json:"gitlab-ci-sonar-scanner-image"`
 }
 
 var (
@@ -38,6 +39,8 @@ var (
 	gitlabCIK8SProdNamespace    = "gitlab-ci-k8s-prod-namespace"
 	gitlabCIK8SStagingCluster   = "gitlab-ci-k8s-staging-cluster"
 	gitlabCIK8SProdCluster      = "gitlab-ci-k8s-prod-cluster"
+	gitlabCIStagingUrl          = "gitlab-ci-staging-url"
+	gitlabCIProdUrl             = "gitlab-ci-prod-url"
 	gitlabCISonarScannerImage   = "gitlab-ci-sonar-scanner-image"
 
 	defaultGitlabCIInstance = GitLabCI{
@@ -50,6 +53,8 @@ var (
 		K8SProdNamespace:        "",
 		K8SDevCluster:           "",
 		K8SProdCluster:          "",
+		StagingUrl:              "",
+		ProdUrl:                 "",
 		SonarQubeScannerImage:   "",
 	}
 )
@@ -57,55 +62,52 @@ var (
 func AddGitlabCIFlagsToCommand(cmd *cobra.Command) {
 	cmd.Flags().StringArrayP(gitlabCITags, "", defaultGitlabCIInstance.Tags, ".gitlab-ci tags")
 	cmd.Flags().StringArrayP(gitlabCIExcept, "", defaultGitlabCIInstance.Excepts, ".gitlab-ci except")
-	cmd.Flags().StringArrayP(gitlabCIDeployStagingTags, "", defaultGitlabCIInstance.K8SDeployStagingEnvTags, ".gitlab-ci except")
-	cmd.Flags().StringArrayP(gitlabCIDeployProdTags, "", defaultGitlabCIInstance.K8SDeployProdEnvTags, ".gitlab-ci except")
-	cmd.Flags().StringP(gitlabCIDeployer, "", defaultGitlabCIInstance.Deployer, ".gitlab-ci except")
-	cmd.Flags().StringP(gitlabCIK8SStagingNamespace, "", defaultGitlabCIInstance.K8SDevNamespace, ".gitlab-ci except")
-	cmd.Flags().StringP(gitlabCIK8SProdNamespace, "", defaultGitlabCIInstance.K8SProdNamespace, ".gitlab-ci except")
-	cmd.Flags().StringP(gitlabCIK8SStagingCluster, "", defaultGitlabCIInstance.K8SDevCluster, ".gitlab-ci except")
-	cmd.Flags().StringP(gitlabCIK8SProdCluster, "", defaultGitlabCIInstance.K8SProdCluster, ".gitlab-ci except")
+	cmd.Flags().StringArrayP(gitlabCIDeployStagingTags, "", defaultGitlabCIInstance.K8SDeployStagingEnvTags, "Runner tags of the staging deployment")
+	cmd.Flags().StringArrayP(gitlabCIDeployProdTags, "", defaultGitlabCIInstance.K8SDeployProdEnvTags, "Runner tags of the production deployment")
+	cmd.Flags().StringP(gitlabCIDeployer, "", defaultGitlabCIInstance.Deployer, "Deployer image with kubectl")
+	cmd.Flags().StringP(gitlabCIK8SStagingNamespace, "", defaultGitlabCIInstance.K8SDevNamespace, "Kubernetes namespace of staging")
+	cmd.Flags().StringP(gitlabCIK8SProdNamespace, "", defaultGitlabCIInstance.K8SProdNamespace, "Kubernetes namespace of production")
+	cmd.Flags().StringP(gitlabCIK8SStagingCluster, "", defaultGitlabCIInstance.K8SDevCluster, "Kubernetes cluster of staging")
+	cmd.Flags().StringP(gitlabCIK8SProdCluster, "", defaultGitlabCIInstance.K8SProdCluster, "Kubernetes cluster of production")
+	cmd.Flags().StringP(gitlabCIStagingUrl, "", defaultGitlabCIInstance.StagingUrl, "URL of the staging environment")
+	cmd.Flags().StringP(gitlabCIProdUrl, "", defaultGitlabCIInstance.ProdUrl, "URL of the production environment")
 
 	cmd.Flags().StringP(gitlabCISonarScannerImage, "", defaultGitlabCIInstance.SonarQubeScannerImage, "sonar-scanner image")
 }
 
-func CreateGitlabCIInstanceFromCommandFlags(cmd *cobra.Command) GitLabCI {
+func CreateGitlabCIInstanceFromCommandFlags(cmd *cobra.Command) (GitLabCI, error) {
+	flags := util.NewFlagReader(cmd)
 	var ci = GitLabCI{}
-	ci.Tags = util.GetValues(cmd, gitlabCITags)
-	ci.Excepts = util.GetValues(cmd, gitlabCIExcept)
-	ci.K8SDeployStagingEnvTags = util.GetValues(cmd, gitlabCIDeployStagingTags)
-	ci.K8SDeployProdEnvTags = util.GetValues(cmd, gitlabCIDeployProdTags)
-	ci.Deployer = util.GetValue(cmd, gitlabCIDeployer)
-	ci.K8SDevNamespace = util.GetValue(cmd, gitlabCIK8SStagingNamespace)
-	ci.K8SProdNamespace = util.GetValue(cmd, gitlabCIK8SProdNamespace)
-	ci.K8SDevCluster = util.GetValue(cmd, gitlabCIK8SStagingCluster)
-	ci.K8SProdCluster = util.GetValue(cmd, gitlabCIK8SProdCluster)
-	ci.SonarQubeScannerImage = util.GetValue(cmd, gitlabCISonarScannerImage)
-	return ci
+	ci.Tags = flags.Values(gitlabCITags)
+	ci.Excepts = flags.Values(gitlabCIExcept)
+	ci.K8SDeployStagingEnvTags = flags.Values(gitlabCIDeployStagingTags)
+	ci.K8SDeployProdEnvTags = flags.Values(gitlabCIDeployProdTags)
+	ci.Deployer = flags.Value(gitlabCIDeployer)
+	ci.K8SDevNamespace = flags.Value(gitlabCIK8SStagingNamespace)
+	ci.K8SProdNamespace = flags.Value(gitlabCIK8SProdNamespace)
+	ci.K8SDevCluster = flags.Value(gitlabCIK8SStagingCluster)
+	ci.K8SProdCluster = flags.Value(gitlabCIK8SProdCluster)
+	ci.StagingUrl = flags.Value(gitlabCIStagingUrl)
+	ci.ProdUrl = flags.Value(gitlabCIProdUrl)
+	ci.SonarQubeScannerImage = flags.Value(gitlabCISonarScannerImage)
+	return ci, flags.Err()
 }
 
-func ParseAndSaveCiCdFile(projectRoot string, templateData *SpringProjectConfig) {
-	configPath := path.Join(projectRoot, "build_pipeline")
-	util.CreateDirIfNotExists(&configPath)
-
-	mo, err := util.GetSpringTemplate(moPath)
-	moFilePath := path.Join(configPath, "mo.sh")
-	err = ioutil.WriteFile(moFilePath, []byte(mo), os.ModePerm)
+func parseAndSaveGitlabCiFile(projectRoot string, templateData *SpringProjectConfig) error {
+	templateStr, err := util.GetSpringTemplate(gitlabCITemplate)
 	if err != nil {
-		util.LogMessageAndExit("Unable to copy mo.sh")
+		return err
 	}
-
-	_, err = exec.Command("chmod", "777", moFilePath).Output()
+	parsedTemplate, err := util.ParseTemplate(templateData, gitlabCI, templateStr)
 	if err != nil {
-		util.LogMessageAndExit("Unable to make mo.sh executable!")
+		return err
 	}
 
-	templateStr, err := util.GetSpringTemplate(gitlabCITemplate)
-	parsedTemplate, err := util.ParseTemplate(templateData, gitlabCI, templateStr)
-
 	filePath := path.Join(projectRoot, gitlabCI)
 	err = ioutil.WriteFile(filePath, []byte(parsedTemplate), os.ModePerm)
 	if err != nil {
-		util.LogMessageAndExit(fmt.Sprintf("Unable to save %s", filePath))
+		return fmt.Errorf("unable to save %s: %w", filePath, err)
 	}
 	log.Printf("%s config file created successfully!", gitlabCI)
+	return nil
 }
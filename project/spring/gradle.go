@@ -7,87 +7,140 @@ import (
 	"log"
 	"os"
 	"path"
+	"regexp"
 )
 
-var (
-	gradleBuildTemplate          = "build.gradle.tmpl"
-	kotlinDslTemplate            = "build.gradle.kts"
-	kotlinDslSettingTemplate     = "settings.gradle.kts"
-	kotlinDslTemplatePath        = fmt.Sprintf("spring/kotlin/%s", kotlinDslTemplate)
-	kotlinSettingDslTemplatePath = fmt.Sprintf("spring/kotlin/%s", kotlinDslSettingTemplate)
-	dockerfileTemplate           = "Dockerfile.tmpl"
-	gradleBuildFileRelativePath  = "build.gradle"
-	dockerFileRelativePath       = "Dockerfile"
+const (
+	// JibVersion is the version of the Jib Gradle plugin the jib feature adds.
+	JibVersion = "2.1.0"
+	// DetektVersion and KtlintGradleVersion are the versions of the lint plugins of Kotlin projects.
+	DetektVersion       = "1.7.4"
+	KtlintGradleVersion = "9.2.1"
 )
 
-func OverwriteJavaGradleBuild(projectRootPath *string, springProjectConfig *SpringProjectConfig) {
-	template := parseGradleTemplate(springProjectConfig)
+// languageBuilds are the changes of the build script of the languages which need more than the Initializr build, the
+// linters run in the check stage of the pipeline.
+var languageBuilds = map[string]func(config *SpringProjectConfig) gradleChanges{
+	Kotlin: func(config *SpringProjectConfig) gradleChanges {
+		return gradleChanges{
+			plugins: []gradlePlugin{
+				{id: "io.gitlab.arturbosch.detekt", version: DetektVersion},
+				{id: "org.jlleitschuh.gradle.ktlint", version: KtlintGradleVersion},
+			},
+			// detekt resolves its command line tool from jcenter
+			repositories: []gradleRepository{{name: "jcenter"}},
+			blocks: []gradleBlock{
+				{template: "detekt", present: regexp.MustCompile(`(?m)^detekt\s*\{`)},
+				{template: "ktlint", present: regexp.MustCompile(`(?m)^ktlint\s*\{`)},
+			},
+		}
+	},
+	Groovy: func(config *SpringProjectConfig) gradleChanges {
+		return gradleChanges{
+			plugins: []gradlePlugin{{id: "codenarc"}},
+			blocks:  []gradleBlock{{template: "codenarc", present: regexp.MustCompile(`(?m)^codenarc\s*\{`)}},
+		}
+	},
+}
 
-	filePath := path.Join(*projectRootPath, gradleBuildFileRelativePath)
-	err := ioutil.WriteFile(filePath, []byte(template), os.ModePerm)
-	if err != nil {
-		log.Printf("Unable to overwrite file %s\n", filePath)
-	}
-	log.Printf("%s updated successfully!", filePath)
+// lintConfigFiles are the configuration files of the linters by language, they are rendered from spring/lint.
+var lintConfigFiles = map[string][]skeletonFile{
+	Kotlin: {
+		{template: "kotlin/editorconfig", target: ".editorconfig"},
+		{template: "kotlin/detekt.yml", target: "detekt.yml"},
+	},
+	Groovy: {
+		{template: "groovy/codenarc.xml", target: "codenarc.xml"},
+	},
 }
 
-func OverwriteKotlinGradleBuild(projectRootPath *string, springProjectConfig *SpringProjectConfig) error {
-	settingDslFilePath := path.Join(*projectRootPath, kotlinDslSettingTemplate)
+var (
+	dockerfileTemplate                = "Dockerfile.tmpl"
+	gradleBuildFileRelativePath       = "build.gradle"
+	kotlinGradleBuildFileRelativePath = "build.gradle.kts"
+	dockerFileRelativePath            = "Dockerfile"
+)
 
-	err := overwriteKotlinTemplate(springProjectConfig, &settingDslFilePath, &kotlinSettingDslTemplatePath)
-	if err != nil {
-		return err
+// ComposeGradleBuild adds the plugins, repositories, dependencies and configuration blocks of the enabled features to
+// the build script Initializr created. The rest of the script, e.g. the dependencies Initializr added, is kept and
+// running it again changes nothing.
+func ComposeGradleBuild(projectRoot string, config *SpringProjectConfig) error {
+	changes := gradleChanges{repositories: []gradleRepository{{name: "mavenCentral"}}}
+	if build, found := languageBuilds[config.Language]; found {
+		changes = changes.add(build(config))
+	}
+	for _, name := range Features() {
+		if added := features[name]; added.build != nil && added.enabled(config) {
+			changes = changes.add(added.build(config))
+		}
 	}
 
-	dslFilePath := path.Join(*projectRootPath, kotlinDslTemplate)
-	err = overwriteKotlinTemplate(springProjectConfig, &dslFilePath, &kotlinDslTemplatePath)
-
-	return err
-}
-
-func overwriteKotlinTemplate(springProjectConfig *SpringProjectConfig, filePath, templatePath *string) error {
-	templateStr, err := util.GetSpringTemplate(*templatePath)
+	buildFile := gradleBuildFile(projectRoot)
+	filePath := path.Join(projectRoot, buildFile)
+	data, err := ioutil.ReadFile(filePath)
 	if err != nil {
-		return err
+		return util.NewFileSystemError(filePath, err)
 	}
-	parsedTemplate, err := util.ParseTemplate(springProjectConfig, "tmp", templateStr)
+	script, err := changes.apply(string(data), dslOf(buildFile), config)
 	if err != nil {
 		return err
 	}
-	err = ioutil.WriteFile(*filePath, []byte(parsedTemplate), os.ModePerm)
-	if err != nil {
-		return err
+	if err = ioutil.WriteFile(filePath, []byte(script), os.ModePerm); err != nil {
+		return util.NewFileSystemError(filePath, err)
 	}
+	log.Printf("%s updated successfully!", filePath)
 	return nil
 }
 
-func CreateGradleDockerfile(projectRootPath *string, springProjectConfig *SpringProjectConfig) {
-	template := parseDockerTemplate(springProjectConfig)
+// CreateLintConfig writes the configuration files of the linters of the project language, e.g. detekt.yml and the
+// .editorconfig ktlint reads for Kotlin projects.
+func CreateLintConfig(projectRoot string, config *SpringProjectConfig) error {
+	for _, file := range lintConfigFiles[config.Language] {
+		templatePath := path.Join("spring/lint", file.template)
+		template, err := util.GetSpringTemplate(templatePath)
+		if err != nil {
+			return err
+		}
+		parsed, err := util.ParseTemplate(config, templatePath, template)
+		if err != nil {
+			return err
+		}
+		filePath := path.Join(projectRoot, file.target)
+		if err = ioutil.WriteFile(filePath, []byte(parsed), 0644); err != nil {
+			return util.NewFileSystemError(filePath, err)
+		}
+	}
+	return nil
+}
+
+// gradleBuildFile returns build.gradle.kts if the project has one, build.gradle otherwise.
+func gradleBuildFile(projectRoot string) string {
+	if exists, _ := util.Exists(path.Join(projectRoot, kotlinGradleBuildFileRelativePath)); exists {
+		return kotlinGradleBuildFileRelativePath
+	}
+	return gradleBuildFileRelativePath
+}
+
+func CreateGradleDockerfile(projectRootPath *string, springProjectConfig *SpringProjectConfig) error {
+	template, err := parseDockerTemplate(springProjectConfig)
+	if err != nil {
+		return err
+	}
 
 	filePath := path.Join(*projectRootPath, dockerFileRelativePath)
-	err := ioutil.WriteFile(filePath, []byte(template), os.ModePerm)
+	err = ioutil.WriteFile(filePath, []byte(template), os.ModePerm)
 	if err != nil {
-		log.Printf("Unable to write file %s\n", filePath)
+		return fmt.Errorf("unable to write file %s: %w", filePath, err)
 	}
 	log.Printf("%s updated successfully!", filePath)
+	return nil
 }
 
-func parseDockerTemplate(dockerTemplateData *SpringProjectConfig) string {
+func parseDockerTemplate(dockerTemplateData *SpringProjectConfig) (string, error) {
 	springTemplate, err := util.GetSpringTemplate(dockerfileTemplate)
-	util.LogAndExit(err, util.InvalidTemplate)
-
-	template, err := util.ParseTemplate(dockerTemplateData, dockerfileTemplate, springTemplate)
-	util.LogAndExit(err, util.InvalidTemplate)
-
-	return template
-}
-
-func parseGradleTemplate(gradleTemplateData *SpringProjectConfig) string {
-	springTemplate, err := util.GetSpringTemplate(gradleBuildTemplate)
-	util.LogAndExit(err, util.InvalidTemplate)
-
-	template, err := util.ParseTemplate(gradleTemplateData, gradleBuildTemplate, springTemplate)
-	util.LogAndExit(err, util.InvalidTemplate)
+	if err != nil {
+		return "", err
+	}
 
-	return template
+	return util.ParseTemplate(dockerTemplateData, dockerfileTemplate, springTemplate)
 }
@@ -1,16 +1,97 @@
 package spring_test
 
-import "testing"
-import "github.com/rocketlaunchercloud/rlctl/project/spring"
-
-func TestOverwriteKotlinGradleBuild(t *testing.T) {
-	var springProjectConfig spring.SpringProjectConfig
-	springProjectConfig.Name = "test"
-	springProjectConfig.EnableKafka = true
-
-	rootPath := "/tmp"
-	err := spring.OverwriteKotlinGradleBuild(&rootPath, &springProjectConfig)
-	if err != nil {
-		t.Fatal("error happened", springProjectConfig)
+import (
+	"github.com/rocketlaunchercloud/rlctl/project/spring"
+	"io/ioutil"
+	"os"
+	"path"
+	"strings"
+	"testing"
+)
+
+func TestComposeGradleBuild(t *testing.T) {
+	tests := []struct {
+		buildFile string
+		script    string
+		expected  []string
+	}{
+		{
+			buildFile: "build.gradle",
+			script: `plugins {
+	id 'org.springframework.boot' version '2.2.5.RELEASE'
+	id "org.sonarqube" version "2.6" // pinned
+	id 'java'
+}
+
+repositories {
+	mavenCentral()
+}
+
+dependencies {
+	implementation 'org.springframework.boot:spring-boot-starter-web'
+	implementation 'org.flywaydb:flyway-core'
+	implementation 'org.springframework.kafka:spring-kafka:2.2.0.RELEASE'
+	testImplementation('org.springframework.boot:spring-boot-starter-test') {
+		exclude group: 'org.junit.vintage', module: 'junit-vintage-engine'
+	}
+}
+`,
+			expected: []string{"\tid \"org.sonarqube\" version \"2.8\" // pinned\n\tid 'java'\n\tid 'jacoco'\n\tid 'com.google.cloud.tools.jib' version '2.1.0'\n}",
+				"implementation 'org.flywaydb:flyway-core'", "implementation 'org.springframework.kafka:spring-kafka:2.2.0.RELEASE'",
+				"\t}\n\timplementation 'org.liquibase:liquibase-core'\n}", "\nsonarqube {", "\njacocoTestReport {", "image = 'registry.example.com/orders'"},
+		},
+		{
+			buildFile: "build.gradle.kts",
+			script: `plugins {
+    id("org.springframework.boot") version "2.2.5.RELEASE"
+    id("com.google.cloud.tools.jib") version "2.2.0"
+    kotlin("jvm") version "1.3.61"
+}
+
+dependencies {
+    implementation("org.jetbrains.kotlin:kotlin-reflect")
+}
+`,
+			expected: []string{"id(\"com.google.cloud.tools.jib\") version \"2.2.0\"\n    kotlin(\"jvm\") version \"1.3.61\"\n    id(\"jacoco\")\n    id(\"org.sonarqube\") version \"2.8\"",
+				"}\n\nrepositories {\n    mavenCentral()\n}\n\ndependencies {", "    implementation(\"org.jetbrains.kotlin:kotlin-reflect\")\n    implementation(\"org.springframework.kafka:spring-kafka\")",
+				"tasks.jacocoTestReport {", "ports = listOf(\"8080\")"},
+		},
+	}
+
+	for _, test := range tests {
+		root, err := ioutil.TempDir("", "rlctl")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer os.RemoveAll(root)
+		file := path.Join(root, test.buildFile)
+		if err = ioutil.WriteFile(file, []byte(test.script), 0644); err != nil {
+			t.Fatal(err)
+		}
+
+		config := spring.DefaultSpringProjectConfig()
+		config.Name = "orders"
+		config.EnableSonar, config.EnableKafka, config.EnableJib = true, true, true
+		config.EnableLiquibase = test.buildFile == "build.gradle"
+		config.DockerConfig.RegistryUrl = "registry.example.com"
+		if err = spring.ComposeGradleBuild(root, &config); err != nil {
+			t.Fatal(err)
+		}
+		composed, err := ioutil.ReadFile(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, expected := range test.expected {
+			if !strings.Contains(string(composed), expected) {
+				t.Errorf("%s misses %q:\n%s", test.buildFile, expected, composed)
+			}
+		}
+
+		if err = spring.ComposeGradleBuild(root, &config); err != nil {
+			t.Fatal(err)
+		}
+		if again, _ := ioutil.ReadFile(file); string(again) != string(composed) {
+			t.Errorf("composing %s twice must change nothing:\n%s", test.buildFile, again)
+		}
 	}
 }
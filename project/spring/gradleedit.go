@@ -0,0 +1,429 @@
+package spring
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// gradleDSL is the language of a build script, build.gradle is Groovy and build.gradle.kts Kotlin.
+type gradleDSL int
+
+const (
+	groovyDSL gradleDSL = iota
+	kotlinDSL
+)
+
+func dslOf(buildFile string) gradleDSL {
+	if strings.HasSuffix(buildFile, ".kts") {
+		return kotlinDSL
+	}
+	return groovyDSL
+}
+
+func (dsl gradleDSL) quote(value string) string {
+	if dsl == kotlinDSL {
+		return `"` + value + `"`
+	}
+	return "'" + value + "'"
+}
+
+func (dsl gradleDSL) indent() string {
+	if dsl == kotlinDSL {
+		return "    "
+	}
+	return "\t"
+}
+
+// gradlePlugin is an entry of the plugins block, core plugins like jacoco have no version.
+type gradlePlugin struct {
+	id      string
+	version string
+}
+
+func (plugin gradlePlugin) line(dsl gradleDSL) string {
+	line := "id " + dsl.quote(plugin.id)
+	if dsl == kotlinDSL {
+		line = "id(" + dsl.quote(plugin.id) + ")"
+	}
+	if plugin.version != "" {
+		line += " version " + dsl.quote(plugin.version)
+	}
+	return line
+}
+
+// gradleDependency is an entry of the dependencies block, notation is group:artifact[:version].
+type gradleDependency struct {
+	configuration string
+	notation      string
+}
+
+func (dependency gradleDependency) line(dsl gradleDSL) string {
+	if dsl == kotlinDSL {
+		return dependency.configuration + "(" + dsl.quote(dependency.notation) + ")"
+	}
+	return dependency.configuration + " " + dsl.quote(dependency.notation)
+}
+
+// module is group:artifact, dependencies are matched without version.
+func (dependency gradleDependency) module() string {
+	parts := strings.SplitN(dependency.notation, ":", 3)
+	if len(parts) < 2 {
+		return dependency.notation
+	}
+	return parts[0] + ":" + parts[1]
+}
+
+func (dependency gradleDependency) version() string {
+	if parts := strings.SplitN(dependency.notation, ":", 3); len(parts) == 3 {
+		return parts[2]
+	}
+	return ""
+}
+
+// gradleRepository is an entry of the repositories block, mavenCentral() or a maven repository with its url.
+type gradleRepository struct {
+	name string
+	url  string
+}
+
+func (repository gradleRepository) line(dsl gradleDSL) string {
+	switch {
+	case repository.url == "":
+		return repository.name + "()"
+	case dsl == kotlinDSL:
+		return "maven { url = uri(" + dsl.quote(repository.url) + ") }"
+	default:
+		return "maven { url " + dsl.quote(repository.url) + " }"
+	}
+}
+
+var (
+	// pluginPattern matches id 'x' version 'y', id("x") version "y", kotlin("jvm") version "y" and core plugins like
+	// jacoco or `java-library`.
+	pluginPattern = regexp.MustCompile(`^(?:(?:id\s*\(?\s*["']([^"']+)["'](?:\s*\))?|kotlin\s*\(\s*["']([^"']+)["']\s*\))` +
+		`(?:\s+version\s*\(?\s*["']([^"']*)["'])?|` + "`?([A-Za-z][\\w-]*)`?" + `\s*(?://.*)?$)`)
+	// dependencyPattern matches implementation 'group:artifact:version' and implementation("group:artifact:version").
+	dependencyPattern  = regexp.MustCompile(`^(\w+)\s*\(?\s*["']([^"':\s]+:[^"':\s]+)(?::([^"':@\s]+))?`)
+	versionPartPattern = regexp.MustCompile(`\d+`)
+)
+
+// gradleEntry is a statement of a block, e.g. a plugin or a dependency with its closure. key is the plugin id or the
+// dependency module, version is empty if the entry has none.
+type gradleEntry struct {
+	key          string
+	version      string
+	versionStart int
+	versionEnd   int
+}
+
+func parsePluginEntry(text string) (gradleEntry, bool) {
+	match := pluginPattern.FindStringSubmatchIndex(text)
+	if match == nil {
+		return gradleEntry{}, false
+	}
+	entry := gradleEntry{versionStart: match[6], versionEnd: match[7]}
+	switch {
+	case match[2] >= 0:
+		entry.key = text[match[2]:match[3]]
+	case match[4] >= 0:
+		entry.key = "org.jetbrains.kotlin." + text[match[4]:match[5]]
+	default:
+		entry.key = text[match[8]:match[9]]
+	}
+	if entry.versionStart >= 0 {
+		entry.version = text[entry.versionStart:entry.versionEnd]
+	}
+	return entry, true
+}
+
+func parseDependencyEntry(text string) (gradleEntry, bool) {
+	match := dependencyPattern.FindStringSubmatchIndex(text)
+	if match == nil {
+		return gradleEntry{}, false
+	}
+	entry := gradleEntry{key: text[match[4]:match[5]], versionStart: match[6], versionEnd: match[7]}
+	if entry.versionStart >= 0 {
+		entry.version = text[entry.versionStart:entry.versionEnd]
+	}
+	return entry, true
+}
+
+// findEntry returns the entry of the top level block with the key, the version offsets are relative to the script.
+func findEntry(script, block, key string, parse func(string) (gradleEntry, bool)) (gradleEntry, bool) {
+	for _, statement := range blockStatements(script, block) {
+		entry, parsed := parse(script[statement[0]:statement[1]])
+		if !parsed || entry.key != key {
+			continue
+		}
+		if entry.versionStart >= 0 {
+			entry.versionStart += statement[0]
+			entry.versionEnd += statement[0]
+		}
+		return entry, true
+	}
+	return gradleEntry{}, false
+}
+
+// upgradeVersion replaces the version of the entry if version is newer, entries without version are kept.
+func upgradeVersion(script string, entry gradleEntry, version string) string {
+	if version == "" || entry.versionStart < 0 || !newerVersion(version, entry.version) {
+		return script
+	}
+	return script[:entry.versionStart] + version + script[entry.versionEnd:]
+}
+
+// newerVersion compares the numeric parts of the versions, e.g. 2.2.10.RELEASE is newer than 2.2.9.RELEASE.
+// Versions from variables like $kotlinVersion are never replaced.
+func newerVersion(version, current string) bool {
+	if strings.Contains(current, "$") {
+		return false
+	}
+	parts, currentParts := versionPartPattern.FindAllString(version, -1), versionPartPattern.FindAllString(current, -1)
+	for i := 0; i < len(parts) && i < len(currentParts); i++ {
+		if part, currentPart := atoi(parts[i]), atoi(currentParts[i]); part != currentPart {
+			return part > currentPart
+		}
+	}
+	return len(parts) > len(currentParts)
+}
+
+func atoi(digits string) int {
+	number, _ := strconv.Atoi(digits)
+	return number
+}
+
+// addGradlePlugins adds the plugins missing in the plugins block and upgrades the older versions, it creates the
+// block if the script has none. Plugins applied with apply plugin are not added again.
+func addGradlePlugins(script string, dsl gradleDSL, plugins []gradlePlugin) string {
+	var lines []string
+	for _, plugin := range plugins {
+		entry, found := findEntry(script, "plugins", plugin.id, parsePluginEntry)
+		switch {
+		case found:
+			script = upgradeVersion(script, entry, plugin.version)
+		case !appliedPlugin(script, plugin.id):
+			lines = append(lines, plugin.line(dsl))
+		}
+	}
+	return addToBlock(script, "plugins", dsl, lines)
+}
+
+// appliedPlugin reports whether the script applies the plugin with apply plugin: 'id' or apply(plugin = "id").
+func appliedPlugin(script, id string) bool {
+	return regexp.MustCompile(`(?m)^\s*apply\s*\(?\s*plugin\s*[:=]\s*["']` + regexp.QuoteMeta(id) + `["']`).MatchString(script)
+}
+
+// addGradleDependencies adds the dependencies whose module is missing in the dependencies block and upgrades the
+// older versions. Dependencies without version, managed by the Spring Boot BOM, are kept.
+func addGradleDependencies(script string, dsl gradleDSL, dependencies []gradleDependency) string {
+	var lines []string
+	for _, dependency := range dependencies {
+		if entry, found := findEntry(script, "dependencies", dependency.module(), parseDependencyEntry); found {
+			script = upgradeVersion(script, entry, dependency.version())
+		} else if !strings.Contains(blockBody(script, "dependencies"), dependency.module()) {
+			lines = append(lines, dependency.line(dsl))
+		}
+	}
+	return addToBlock(script, "dependencies", dsl, lines)
+}
+
+// addGradleRepositories adds the repositories missing in the repositories block, maven repositories are matched by
+// url.
+func addGradleRepositories(script string, dsl gradleDSL, repositories []gradleRepository) string {
+	body := blockBody(script, "repositories")
+	var lines []string
+	for _, repository := range repositories {
+		if repository.url == "" && !containsWord(body, repository.name) ||
+			repository.url != "" && !strings.Contains(body, strings.TrimSuffix(repository.url, "/")) {
+			lines = append(lines, repository.line(dsl))
+		}
+	}
+	return addToBlock(script, "repositories", dsl, lines)
+}
+
+// containsWord reports whether word occurs in text delimited by characters which cannot be part of a plugin id.
+func containsWord(text, word string) bool {
+	return regexp.MustCompile(`(^|[^\w.-])` + regexp.QuoteMeta(word) + `([^\w.-]|$)`).MatchString(text)
+}
+
+// blockBody returns the content of the top level block, empty if the script has none.
+func blockBody(script, name string) string {
+	open, end, found := findBlock(script, name)
+	if !found {
+		return ""
+	}
+	return script[open+1 : end]
+}
+
+// addToBlock appends the lines to the top level block, indented like the block content. A missing plugins block is
+// created at the top after the buildscript block, a missing repositories block before the dependencies block and
+// other missing blocks at the end.
+func addToBlock(script, name string, dsl gradleDSL, lines []string) string {
+	if len(lines) == 0 {
+		return script
+	}
+	open, end, found := findBlock(script, name)
+	if !found {
+		block := name + " {\n" + indentLines(lines, scriptIndent(script, dsl)) + "}\n"
+		if dependencies, _, found := findBlock(script, "dependencies"); name == "repositories" && found {
+			position := strings.LastIndex(script[:dependencies], "dependencies")
+			return script[:position] + block + "\n" + script[position:]
+		}
+		if name != "plugins" {
+			return strings.TrimRight(script, "\n") + "\n\n" + block
+		}
+		position := 0
+		if _, buildscriptEnd, found := findBlock(script, "buildscript"); found {
+			position = buildscriptEnd + 1
+			block = "\n\n" + block
+		}
+		return script[:position] + block + script[position:]
+	}
+
+	indent := dsl.indent()
+	for _, line := range strings.Split(script[open+1:end], "\n") {
+		if trimmed := strings.TrimLeft(line, " \t"); trimmed != "" {
+			indent = line[:len(line)-len(trimmed)]
+			break
+		}
+	}
+	// Insert after the last entry, blank lines before the closing brace are kept
+	position := len(strings.TrimRight(script[:end], " \t\r\n"))
+	if position == open+1 {
+		return script[:position] + "\n" + indentLines(lines, indent) + script[position:]
+	}
+	return script[:position] + "\n" + strings.TrimSuffix(indentLines(lines, indent), "\n") + script[position:]
+}
+
+// scriptIndent returns the indentation of the first indented line, the indentation of the DSL if there is none.
+func scriptIndent(script string, dsl gradleDSL) string {
+	for _, line := range strings.Split(script, "\n") {
+		if trimmed := strings.TrimLeft(line, " \t"); trimmed != "" && len(trimmed) < len(line) {
+			return line[:len(line)-len(trimmed)]
+		}
+	}
+	return dsl.indent()
+}
+
+// reindent replaces the indentation from at the start of the lines by to, e.g. the spaces of a template by the tabs
+// of the script.
+func reindent(text, from, to string) string {
+	if from == to {
+		return text
+	}
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		depth := 0
+		for ; strings.HasPrefix(line, from); depth++ {
+			line = line[len(from):]
+		}
+		lines[i] = strings.Repeat(to, depth) + line
+	}
+	return strings.Join(lines, "\n")
+}
+
+func indentLines(lines []string, indent string) string {
+	var builder strings.Builder
+	for _, line := range lines {
+		builder.WriteString(indent + line + "\n")
+	}
+	return builder.String()
+}
+
+// blockStatements returns the offsets of the statements of the top level block, a statement ends with its line unless
+// it opens a closure, e.g. a dependency with excludes. Blank lines and comments are skipped.
+func blockStatements(script, name string) [][2]int {
+	open, end, found := findBlock(script, name)
+	if !found {
+		return nil
+	}
+	braces := map[int]bool{}
+	for _, brace := range scanBraces(script) {
+		braces[brace] = true
+	}
+
+	var statements [][2]int
+	depth, start := 0, -1
+	for lineStart := open + 1; lineStart < end; {
+		lineEnd := end
+		if newline := strings.IndexByte(script[lineStart:end], '\n'); newline >= 0 {
+			lineEnd = lineStart + newline
+		}
+		line := strings.TrimSpace(script[lineStart:lineEnd])
+		if start < 0 && line != "" && !strings.HasPrefix(line, "//") && !strings.HasPrefix(line, "/*") && !strings.HasPrefix(line, "*") {
+			start = lineStart + strings.Index(script[lineStart:lineEnd], line)
+		}
+		for i := lineStart; i < lineEnd; i++ {
+			if braces[i] && script[i] == '{' {
+				depth++
+			} else if braces[i] {
+				depth--
+			}
+		}
+		if start >= 0 && depth <= 0 {
+			statements = append(statements, [2]int{start, lineStart + len(strings.TrimRight(script[lineStart:lineEnd], " \t\r"))})
+			start, depth = -1, 0
+		}
+		lineStart = lineEnd + 1
+	}
+	return statements
+}
+
+// findBlock returns the offsets of the braces of the first top level block with the name. Braces in strings and
+// comments are ignored.
+func findBlock(script, name string) (int, int, bool) {
+	depth := 0
+	open := -1
+	for _, brace := range scanBraces(script) {
+		if script[brace] == '{' {
+			if depth == 0 && blockName(script[:brace]) == name {
+				open = brace
+			}
+			depth++
+			continue
+		}
+		depth--
+		if depth == 0 && open >= 0 {
+			return open, brace, true
+		}
+	}
+	return -1, -1, false
+}
+
+// blockName returns the identifier in front of an opening brace, e.g. plugins or tasks.jacocoTestReport.
+func blockName(prefix string) string {
+	prefix = strings.TrimRight(prefix, " \t\r\n")
+	start := strings.LastIndexAny(prefix, " \t\r\n;}{") + 1
+	return prefix[start:]
+}
+
+// scanBraces returns the offsets of the braces outside of strings and comments.
+func scanBraces(script string) []int {
+	var braces []int
+	for i := 0; i < len(script); i++ {
+		switch c := script[i]; {
+		case c == '{' || c == '}':
+			braces = append(braces, i)
+		case c == '/' && strings.HasPrefix(script[i:], "//"):
+			if end := strings.IndexByte(script[i:], '\n'); end >= 0 {
+				i += end
+			} else {
+				i = len(script)
+			}
+		case c == '/' && strings.HasPrefix(script[i:], "/*"):
+			if end := strings.Index(script[i+2:], "*/"); end >= 0 {
+				i += end + 3
+			} else {
+				i = len(script)
+			}
+		case c == '\'' || c == '"':
+			for i++; i < len(script) && script[i] != c && script[i] != '\n'; i++ {
+				if script[i] == '\\' {
+					i++
+				}
+			}
+		}
+	}
+	return braces
+}
@@ -0,0 +1,64 @@
+package spring
+
+import (
+	"fmt"
+	"github.com/rocketlaunchercloud/rlctl/util"
+	"io/ioutil"
+	"log"
+	"os"
+	"path"
+	"strings"
+)
+
+var (
+	jenkinsfileTemplate = "buildpipeline/Jenkinsfile.tmpl"
+	jenkinsfile         = "Jenkinsfile"
+)
+
+func parseAndSaveJenkinsfile(projectRoot string, templateData *SpringProjectConfig) error {
+	data := pipelineTemplateData{
+		SpringProjectConfig: templateData,
+		Pipeline:            NewPipeline(templateData),
+	}
+	data.Condition = jenkinsCondition(data.Pipeline.Excepts)
+
+	templateStr, err := util.GetSpringTemplate(jenkinsfileTemplate)
+	if err != nil {
+		return err
+	}
+	parsedTemplate, err := util.ParseTemplate(data, jenkinsfile, templateStr)
+	if err != nil {
+		return err
+	}
+
+	filePath := path.Join(projectRoot, jenkinsfile)
+	err = ioutil.WriteFile(filePath, []byte(parsedTemplate), os.ModePerm)
+	if err != nil {
+		return fmt.Errorf("unable to save %s: %w", filePath, err)
+	}
+	log.Printf("%s created successfully!", jenkinsfile)
+	return nil
+}
+
+// jenkinsCondition translates GitLab CI excepts into the body of a declarative `when` directive.
+func jenkinsCondition(excepts []string) string {
+	var conditions []string
+	for _, except := range excepts {
+		switch except {
+		case "schedules":
+			conditions = append(conditions, "triggeredBy 'TimerTrigger'")
+		case "tags":
+			conditions = append(conditions, "buildingTag()")
+		case "merge_requests":
+			conditions = append(conditions, "changeRequest()")
+		case "triggers", "api", "web", "pushes", "branches":
+			// Jenkins has no portable equivalent for these GitLab sources
+		default:
+			conditions = append(conditions, fmt.Sprintf("branch '%s'", except))
+		}
+	}
+	if len(conditions) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("not { anyOf { %s } }", strings.Join(conditions, "; "))
+}
@@ -12,37 +12,50 @@ const (
 	K8SStagingTemplate = "kubernetes/stg/kube-config.yml"
 )
 
-func parseK8STemplates(projectConfig *SpringProjectConfig) (string, string) {
+func parseK8STemplates(projectConfig *SpringProjectConfig) (string, string, error) {
 	prodTmplStr, err := util.GetSpringTemplate(K8SProdTemplate)
-	util.LogAndExit(err, util.InvalidTemplate)
+	if err != nil {
+		return "", "", err
+	}
 
 	parsedProdTemplate, err := util.ParseTemplate(projectConfig, "kube-config", prodTmplStr)
-	util.LogAndExit(err, util.InvalidTemplate)
+	if err != nil {
+		return "", "", err
+	}
 
 	stgTmplStr, err := util.GetSpringTemplate(K8SStagingTemplate)
-	util.LogAndExit(err, util.InvalidTemplate)
+	if err != nil {
+		return "", "", err
+	}
 
 	parsedStgTemplate, err := util.ParseTemplate(projectConfig, "kube-config", stgTmplStr)
-	util.LogAndExit(err, util.InvalidTemplate)
+	if err != nil {
+		return "", "", err
+	}
 
-	return parsedProdTemplate, parsedStgTemplate
+	return parsedProdTemplate, parsedStgTemplate, nil
 }
 
-func SaveK8sTemplates(projectRoot *string, projectConfig *SpringProjectConfig) {
-	prod, stg := parseK8STemplates(projectConfig)
-
-	kubernetesConfigPath := path.Join(*projectRoot, "kubernetes")
-	util.CreateDirIfNotExists(&kubernetesConfigPath)
+func SaveK8sTemplates(projectRoot *string, projectConfig *SpringProjectConfig) error {
+	prod, stg, err := parseK8STemplates(projectConfig)
+	if err != nil {
+		return err
+	}
 
 	stgPath := path.Join(*projectRoot, "kubernetes/stg")
-	util.CreateDirIfNotExists(&stgPath)
+	if err = os.MkdirAll(stgPath, os.ModePerm); err != nil {
+		return err
+	}
 
 	prodPath := path.Join(*projectRoot, "kubernetes/prod")
-	util.CreateDirIfNotExists(&prodPath)
+	if err = os.MkdirAll(prodPath, os.ModePerm); err != nil {
+		return err
+	}
 
-	err := ioutil.WriteFile(path.Join(stgPath, "kube-config.yml"), []byte(stg), os.ModePerm)
-	util.LogAndExit(err, util.FileNotFound)
+	err = ioutil.WriteFile(path.Join(stgPath, "kube-config.yml"), []byte(stg), os.ModePerm)
+	if err != nil {
+		return err
+	}
 
-	err = ioutil.WriteFile(path.Join(prodPath, "kube-config.yml"), []byte(prod), os.ModePerm)
-	util.LogAndExit(err, util.FileNotFound)
+	return ioutil.WriteFile(path.Join(prodPath, "kube-config.yml"), []byte(prod), os.ModePerm)
 }
@@ -0,0 +1,177 @@
+package spring
+
+import (
+	"github.com/rocketlaunchercloud/rlctl/util"
+	"gopkg.in/yaml.v2"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	// ManifestFile stores the settings and the template version a project was generated with, it is a manifest of
+	// the spring command.
+	ManifestFile = "rlctl.yaml"
+	// TemplateVersion is increased whenever a template change should reach the generated projects, the change is
+	// listed in templateChanges.
+	TemplateVersion = 4
+	// MergeBaseDirectory holds copies of the generated YAML files, they are the base of the next merge.
+	MergeBaseDirectory = ".rlctl/base"
+)
+
+// templateChanges lists the languages affected by every template version, nil affects all projects. Version 2 moved
+// the Kubernetes Deployments to apps/v1, versions 3 and 4 run the linters of Kotlin and Groovy projects in the check
+// stage.
+var templateChanges = []struct {
+	version   int
+	languages []string
+}{
+	{version: 2},
+	{version: 3, languages: []string{Kotlin}},
+	{version: 4, languages: []string{Groovy}},
+}
+
+// RequiredTemplateVersion returns the latest template version which changed the templates of the project, projects
+// generated with an older version are outdated.
+func RequiredTemplateVersion(config SpringProjectConfig) int {
+	language := config.Language
+	if language == "" {
+		language = Java
+	}
+	required := 1
+	for _, change := range templateChanges {
+		affected := change.languages == nil
+		for _, changed := range change.languages {
+			affected = affected || changed == language
+		}
+		if affected {
+			required = change.version
+		}
+	}
+	return required
+}
+
+// StoredManifest is the content of ManifestFile.
+type StoredManifest struct {
+	TemplateVersion int                 `yaml:"template-version"`
+	Spring          SpringProjectConfig `yaml:"spring"`
+}
+
+// saveManifestFile writes ManifestFile with the current template version into the project.
+func saveManifestFile(projectRoot string, config SpringProjectConfig) error {
+	return StoredManifest{TemplateVersion: TemplateVersion, Spring: config}.save(projectRoot)
+}
+
+// isMergedFile reports whether the merge policy merges the generated file instead of replacing it.
+func isMergedFile(file string) bool {
+	if ext := filepath.Ext(file); ext != ".yml" && ext != ".yaml" {
+		return false
+	}
+	return file != ManifestFile && !strings.HasPrefix(file, MergeBaseDirectory+"/")
+}
+
+// saveMergeBase copies the merged files of the generated project into MergeBaseDirectory and returns the paths of the
+// copies.
+func saveMergeBase(projectRoot string, files []string) ([]string, error) {
+	var copies []string
+	for _, file := range files {
+		if !isMergedFile(file) {
+			continue
+		}
+		data, err := ioutil.ReadFile(filepath.Join(projectRoot, filepath.FromSlash(file)))
+		if err != nil {
+			return nil, err
+		}
+		base := path.Join(MergeBaseDirectory, file)
+		target := filepath.Join(projectRoot, filepath.FromSlash(base))
+		if err = os.MkdirAll(filepath.Dir(target), os.ModePerm); err != nil {
+			return nil, err
+		}
+		if err = ioutil.WriteFile(target, data, 0644); err != nil {
+			return nil, err
+		}
+		copies = append(copies, base)
+	}
+	return copies, nil
+}
+
+// ManagedFiles renders the files which follow the templates for the lifetime of the project: the pipeline, the
+// Dockerfile of Gradle projects and the Kubernetes manifests. The keys are slash separated paths.
+func ManagedFiles(config SpringProjectConfig) (map[string][]byte, error) {
+	files := map[string][]byte{}
+	if config.EnableGitLabCI {
+		var err error
+		if files, err = renderFiles(&config, ParseAndSaveCiCdFile); err != nil {
+			return nil, err
+		}
+	}
+
+	if config.BuildTool == Gradle {
+		dockerfile, err := parseDockerTemplate(&config)
+		if err != nil {
+			return nil, err
+		}
+		files[dockerFileRelativePath] = []byte(dockerfile)
+	}
+
+	prod, stg, err := parseK8STemplates(&config)
+	if err != nil {
+		return nil, err
+	}
+	files[K8SProdTemplate] = []byte(prod)
+	files[K8SStagingTemplate] = []byte(stg)
+	return files, nil
+}
+
+// renderFiles runs save in a temporary directory and returns the files it wrote by slash separated path.
+func renderFiles(config *SpringProjectConfig, save func(projectRoot string, config *SpringProjectConfig) error) (map[string][]byte, error) {
+	dir, err := ioutil.TempDir("", "rlctl-render-")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+	if err = save(dir, config); err != nil {
+		return nil, err
+	}
+	paths, err := listFiles(dir)
+	if err != nil {
+		return nil, err
+	}
+	files := map[string][]byte{}
+	for _, file := range paths {
+		if files[file], err = ioutil.ReadFile(filepath.Join(dir, filepath.FromSlash(file))); err != nil {
+			return nil, err
+		}
+	}
+	return files, nil
+}
+
+// LoadManifestFile reads ManifestFile of a generated project, settings missing in the file keep their defaults.
+func LoadManifestFile(projectRoot string) (*StoredManifest, error) {
+	file := path.Join(projectRoot, ManifestFile)
+	data, err := ioutil.ReadFile(file)
+	if os.IsNotExist(err) {
+		return nil, util.NewValidationError("%s not found, it is written by rlctl spring and can be created with rlctl spring --save-manifest", file)
+	}
+	if err != nil {
+		return nil, util.NewFileSystemError(file, err)
+	}
+	manifest := &StoredManifest{Spring: DefaultSpringProjectConfig()}
+	if err = yaml.Unmarshal(data, manifest); err != nil {
+		return nil, util.NewValidationError("invalid %s: %v", file, err)
+	}
+	return manifest, nil
+}
+
+// save writes the manifest into the project, the output directory is specific to the machine and left out.
+func (manifest StoredManifest) save(projectRoot string) error {
+	manifest.Spring.OutputDirectory = ""
+	data, err := yaml.Marshal(manifest)
+	if err != nil {
+		return err
+	}
+	file := path.Join(projectRoot, ManifestFile)
+	return util.NewFileSystemError(file, ioutil.WriteFile(file, data, 0644))
+}
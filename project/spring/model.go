@@ -1,29 +1,68 @@
 package spring
 
+import (
+	"github.com/rocketlaunchercloud/rlctl/util"
+	"time"
+)
+
+// SpringProjectConfig describes the project to generate. The yaml keys are the names of the spring command flags.
 type SpringProjectConfig struct {
-	BuildTool                  string
-	Language                   string
-	SpringBootVersion          string
-	Name                       string
-	Description                string
-	Group                      string
-	Version                    string
-	BuildPath                  string
-	ServerProtocol             string
-	ServerHost                 string
-	ServerPort                 string
-	JavaSourceCompatibility    string
-	JpaDatabase                string
-	EnableJPA                  bool
-	EnableLiquibase            bool
-	EnableSecurity             bool
-	EnableOAuth2               bool
-	EnableAzureActiveDirectory bool
-	EnableGitLabCI             bool
-	EnableKafka                bool
-	EnableSonar                bool
-	EnableJacoco               bool
-	SonarQubeConfig            SonarQube
-	DockerConfig               Docker
-	GitLabCIConfig             GitLabCI
+	BuildTool                  string        `yaml:"build-tool" json:"build-tool"`
+	Offline                    bool          `yaml:"offline" json:"offline"`
+	InitializrUrl              string        `yaml:"initializr-url" json:"initializr-url"`
+	CacheTTL                   time.Duration `yaml:"cache-ttl" json:"cache-ttl"`
+	Language                   string        `yaml:"language" json:"language"`
+	SpringBootVersion          string        `yaml:"spring-boot-version" json:"spring-boot-version"`
+	Name                       string        `yaml:"name" json:"name"`
+	Description                string        `yaml:"description" json:"description"`
+	Group                      string        `yaml:"group" json:"group"`
+	PackageName                string        `yaml:"package-name" json:"package-name"`
+	Packaging                  string        `yaml:"packaging" json:"packaging"`
+	Version                    string        `yaml:"version" json:"version"`
+	BuildPath                  string        `yaml:"build-path" json:"build-path"`
+	OutputDirectory            string        `yaml:"output-dir,omitempty" json:"output-dir,omitempty"`
+	ServerProtocol             string        `yaml:"server-protocol" json:"server-protocol"`
+	ServerHost                 string        `yaml:"server-host" json:"server-host"`
+	ServerPort                 string        `yaml:"server-port" json:"server-port"`
+	JavaSourceCompatibility    string        `yaml:"java-source-compatibility" json:"java-source-compatibility"`
+	JpaDatabase                string        `yaml:"jpa-database" json:"jpa-database"`
+	EnableJPA                  bool          `yaml:"jpa-enabled" json:"jpa-enabled"`
+	EnableLiquibase            bool          `yaml:"liquibase-enabled" json:"liquibase-enabled"`
+	EnableSecurity             bool          `yaml:"security-enabled" json:"security-enabled"`
+	EnableOAuth2               bool          `yaml:"security-oauth2" json:"security-oauth2"`
+	EnableAzureActiveDirectory bool          `yaml:"azure-enabled" json:"azure-enabled"`
+	EnableGitLabCI             bool          `yaml:"gitlab-ci-enabled" json:"gitlab-ci-enabled"`
+	CIPipeline                 string        `yaml:"ci" json:"ci"`
+	EnableKafka                bool          `yaml:"kafka-enabled" json:"kafka-enabled"`
+	EnableSonar                bool          `yaml:"sonar-enabled" json:"sonar-enabled"`
+	EnableJacoco               bool          `yaml:"jacoco-enabled" json:"jacoco-enabled"`
+	EnableJib                  bool          `yaml:"jib-enabled" json:"jib-enabled"`
+	SonarQubeConfig            SonarQube     `yaml:",inline" json:"sonar"`
+	DockerConfig               Docker        `yaml:",inline" json:"docker"`
+	GitLabCIConfig             GitLabCI      `yaml:",inline" json:"gitlab-ci"`
+}
+
+// DefaultSpringProjectConfig returns the defaults of the spring command flags.
+func DefaultSpringProjectConfig() SpringProjectConfig {
+	return SpringProjectConfig{
+		BuildTool:               Gradle,
+		InitializrUrl:           DefaultInitializrUrl,
+		CacheTTL:                util.DefaultCacheTTL,
+		Language:                Java,
+		SpringBootVersion:       SpringBootLatestVersion,
+		Packaging:               Jar,
+		BuildPath:               "./build",
+		ServerProtocol:          "http",
+		ServerHost:              "localhost",
+		ServerPort:              "8080",
+		JavaSourceCompatibility: "11",
+		JpaDatabase:             "MYSQL",
+		EnableJPA:               true,
+		EnableGitLabCI:          true,
+		CIPipeline:              GitLabCIPipeline,
+		EnableJacoco:            true,
+		SonarQubeConfig:         defaultSonarQubeInstance,
+		DockerConfig:            defaultDockerInstance,
+		GitLabCIConfig:          defaultGitlabCIInstance,
+	}
 }
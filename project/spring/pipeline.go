@@ -0,0 +1,148 @@
+package spring
+
+import (
+	"fmt"
+	"github.com/rocketlaunchercloud/rlctl/util"
+	"io/ioutil"
+	"os"
+	"path"
+	"strings"
+)
+
+const (
+	GitLabCIPipeline      = "gitlab-ci"
+	GitHubActionsPipeline = "github-actions"
+	JenkinsPipeline       = "jenkins"
+	TektonPipeline        = "tekton"
+
+	StagingEnvironment    = "staging"
+	ProductionEnvironment = "production"
+)
+
+// CIPipelines lists the supported CI pipeline generators.
+var CIPipelines = []string{GitLabCIPipeline, GitHubActionsPipeline, JenkinsPipeline, TektonPipeline}
+
+// lintTasks are the Gradle tasks of the linters ComposeGradleBuild adds for the language.
+var lintTasks = map[string][]string{
+	Kotlin: {"detekt", "ktlintCheck"},
+	Groovy: {"codenarcMain", "codenarcTest"},
+}
+
+// GradleCheckTasks returns the Gradle tasks the check stage runs, the linters of the language and check.
+func (config *SpringProjectConfig) GradleCheckTasks() string {
+	return strings.Join(append(append([]string{}, lintTasks[config.Language]...), "check"), " ")
+}
+
+// Pipeline is the CI-neutral description of the build pipeline. Every CI generator renders the same stages
+// (build, check, sonar, pack, deploy) from it, only the syntax differs between the CI systems.
+type Pipeline struct {
+	Stages            []string
+	RunnerTags        []string
+	Excepts           []string
+	BuilderImage      string
+	DeployerImage     string
+	BashImage         string
+	SonarScannerImage string
+	ImageRepository   string
+	Environments      []PipelineEnvironment
+}
+
+// PipelineEnvironment describes a Kubernetes environment the pipeline deploys to.
+type PipelineEnvironment struct {
+	Name           string
+	Cluster        string
+	Namespace      string
+	RunnerTags     []string
+	ManifestPath   string
+	DependsOn      string
+	ManualApproval bool
+}
+
+type pipelineTemplateData struct {
+	*SpringProjectConfig
+	Pipeline  Pipeline
+	Condition string
+}
+
+// NewPipeline maps the GitLabCI settings of the project onto the CI-neutral pipeline model.
+func NewPipeline(config *SpringProjectConfig) Pipeline {
+	ci := config.GitLabCIConfig
+
+	stages := []string{"build", "check"}
+	if config.EnableSonar {
+		stages = append(stages, "sonar")
+	}
+	stages = append(stages, "pack", "deploy")
+
+	return Pipeline{
+		Stages:            stages,
+		RunnerTags:        ci.Tags,
+		Excepts:           ci.Excepts,
+		BuilderImage:      config.DockerConfig.Image,
+		DeployerImage:     ci.Deployer,
+		BashImage:         config.DockerConfig.BashImage,
+		SonarScannerImage: ci.SonarQubeScannerImage,
+		ImageRepository:   fmt.Sprintf("%s/%s", config.DockerConfig.RegistryUrl, config.Name),
+		Environments: []PipelineEnvironment{
+			{
+				Name:         StagingEnvironment,
+				Cluster:      ci.K8SDevCluster,
+				Namespace:    ci.K8SDevNamespace,
+				RunnerTags:   ci.K8SDeployStagingEnvTags,
+				ManifestPath: "kubernetes/stg/kube-config.yml",
+			},
+			{
+				Name:           ProductionEnvironment,
+				Cluster:        ci.K8SProdCluster,
+				Namespace:      ci.K8SProdNamespace,
+				RunnerTags:     ci.K8SDeployProdEnvTags,
+				ManifestPath:   "kubernetes/prod/kube-config.yml",
+				DependsOn:      StagingEnvironment,
+				ManualApproval: true,
+			},
+		},
+	}
+}
+
+// ParseAndSaveCiCdFile generates the pipeline configuration of the CI system selected for the project.
+func ParseAndSaveCiCdFile(projectRoot string, templateData *SpringProjectConfig) error {
+	if err := saveMoScript(projectRoot); err != nil {
+		return err
+	}
+
+	switch templateData.CIPipeline {
+	case GitHubActionsPipeline:
+		return parseAndSaveGitHubActionsFile(projectRoot, templateData)
+	case JenkinsPipeline:
+		return parseAndSaveJenkinsfile(projectRoot, templateData)
+	case TektonPipeline:
+		return parseAndSaveTektonFiles(projectRoot, templateData)
+	case GitLabCIPipeline, "":
+		return parseAndSaveGitlabCiFile(projectRoot, templateData)
+	default:
+		return util.NewValidationError("unsupported CI pipeline %s", templateData.CIPipeline)
+	}
+}
+
+// saveMoScript copies mo.sh which is used by all pipelines to render the Kubernetes manifests.
+func saveMoScript(projectRoot string) error {
+	configPath := path.Join(projectRoot, "build_pipeline")
+	if err := os.MkdirAll(configPath, os.ModePerm); err != nil {
+		return err
+	}
+
+	mo, err := util.GetSpringTemplate(moPath)
+	if err != nil {
+		return err
+	}
+	moFilePath := path.Join(configPath, "mo.sh")
+	err = ioutil.WriteFile(moFilePath, []byte(mo), os.ModePerm)
+	if err != nil {
+		return fmt.Errorf("unable to copy mo.sh: %w", err)
+	}
+
+	if err = os.Chmod(moFilePath, 0777); err != nil {
+		return fmt.Errorf("unable to make mo.sh executable: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,281 @@
+package spring_test
+
+import (
+	"bytes"
+	"github.com/rocketlaunchercloud/rlctl/project/spring"
+	"gopkg.in/yaml.v2"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+// emptySteps matches declarative Jenkins steps without a step, which Jenkins rejects.
+var emptySteps = regexp.MustCompile(`steps \{\s*\}`)
+
+func TestJenkinsfile(t *testing.T) {
+	expected := map[string][]string{
+		spring.Gradle: {"GRADLE_USER_HOME", "sh './gradlew clean build -x test --build-cache --parallel'",
+			"sh './gradlew check --build-cache --parallel'", "artifacts: 'build/libs/**'"},
+		spring.Maven: {"MAVEN_OPTS", "sh './mvnw -B clean package -DskipTests'", "sh './mvnw -B verify'",
+			"artifacts: 'target/*.?ar'"},
+	}
+	for buildTool, contents := range expected {
+		jenkinsfile := string(renderPipeline(t, spring.JenkinsPipeline, buildTool, "Jenkinsfile"))
+		for _, content := range contents {
+			if !strings.Contains(jenkinsfile, content) {
+				t.Errorf("the %s Jenkinsfile misses %q:\n%s", buildTool, content, jenkinsfile)
+			}
+		}
+		if emptySteps.MatchString(jenkinsfile) {
+			t.Errorf("the %s Jenkinsfile has a stage without steps:\n%s", buildTool, jenkinsfile)
+		}
+		if strings.Count(jenkinsfile, "{") != strings.Count(jenkinsfile, "}") {
+			t.Errorf("the braces of the %s Jenkinsfile are unbalanced:\n%s", buildTool, jenkinsfile)
+		}
+		if !strings.Contains(jenkinsfile, "when { not { anyOf { triggeredBy 'TimerTrigger' } } }") {
+			t.Errorf("the excepts of the %s Jenkinsfile are not translated:\n%s", buildTool, jenkinsfile)
+		}
+	}
+}
+
+type tektonResource struct {
+	Kind     string
+	Metadata struct {
+		Name string
+	}
+	Spec struct {
+		Steps []struct {
+			Name   string
+			Script string
+			Args   []string
+		}
+		Tasks []struct {
+			Name     string
+			RunAfter []string `yaml:"runAfter"`
+			TaskRef  struct {
+				Name string
+			} `yaml:"taskRef"`
+		}
+	}
+}
+
+func TestTektonFiles(t *testing.T) {
+	scripts := map[string]map[string]string{
+		spring.Gradle: {
+			"build": "./gradlew clean build -x test --build-cache --parallel\n",
+			"check": "./gradlew check --build-cache --parallel\n",
+		},
+		spring.Maven: {
+			"build": "./mvnw -B clean package -DskipTests\n",
+			"check": "./mvnw -B verify\n",
+		},
+	}
+	for buildTool, expected := range scripts {
+		tasks := decodeTekton(t, renderPipeline(t, spring.TektonPipeline, buildTool, "tekton/tasks.yml"))
+		names := map[string]bool{}
+		for _, task := range tasks {
+			names[task.Metadata.Name] = true
+			if task.Kind != "Task" || len(task.Spec.Steps) == 0 {
+				t.Errorf("%s: unexpected task %+v", buildTool, task)
+			}
+			for _, step := range task.Spec.Steps {
+				if step.Script == "" && len(step.Args) == 0 {
+					t.Errorf("%s: step %s of %s runs nothing", buildTool, step.Name, task.Metadata.Name)
+				}
+				if script, found := expected[step.Name]; found && step.Script != script {
+					t.Errorf("%s: expected the %s script %q, got %q", buildTool, step.Name, script, step.Script)
+				}
+			}
+		}
+
+		pipelines := decodeTekton(t, renderPipeline(t, spring.TektonPipeline, buildTool, "tekton/pipeline.yml"))
+		if len(pipelines) != 1 || pipelines[0].Kind != "Pipeline" {
+			t.Fatalf("%s: unexpected pipeline %+v", buildTool, pipelines)
+		}
+		runs := map[string]bool{}
+		for _, task := range pipelines[0].Spec.Tasks {
+			if !names[task.TaskRef.Name] {
+				t.Errorf("%s: task %s refers to the unknown task %s", buildTool, task.Name, task.TaskRef.Name)
+			}
+			for _, previous := range task.RunAfter {
+				if !runs[previous] {
+					t.Errorf("%s: task %s runs after the unknown task %s", buildTool, task.Name, previous)
+				}
+			}
+			runs[task.Name] = true
+		}
+		for _, task := range []string{"build", "check", "sonar", "pack", "deploy-staging", "deploy-production"} {
+			if !runs[task] {
+				t.Errorf("%s: the pipeline misses the task %s", buildTool, task)
+			}
+		}
+	}
+}
+
+func TestNewPipeline(t *testing.T) {
+	config := spring.DefaultSpringProjectConfig()
+	config.Name = "orders"
+	config.DockerConfig.RegistryUrl = "registry.example.com"
+
+	stages := map[bool][]string{
+		false: {"build", "check", "pack", "deploy"},
+		true:  {"build", "check", "sonar", "pack", "deploy"},
+	}
+	for sonar, expected := range stages {
+		config.EnableSonar = sonar
+		pipeline := spring.NewPipeline(&config)
+		if strings.Join(pipeline.Stages, ",") != strings.Join(expected, ",") {
+			t.Errorf("expected the stages %v with sonar %t, got %v", expected, sonar, pipeline.Stages)
+		}
+	}
+
+	pipeline := spring.NewPipeline(&config)
+	if pipeline.ImageRepository != "registry.example.com/orders" {
+		t.Errorf("unexpected image repository %s", pipeline.ImageRepository)
+	}
+	if len(pipeline.Environments) != 2 {
+		t.Fatalf("expected a staging and a production environment, got %+v", pipeline.Environments)
+	}
+	staging, production := pipeline.Environments[0], pipeline.Environments[1]
+	if staging.Name != spring.StagingEnvironment || staging.DependsOn != "" || staging.ManualApproval {
+		t.Errorf("unexpected staging environment %+v", staging)
+	}
+	if production.Name != spring.ProductionEnvironment || production.DependsOn != spring.StagingEnvironment ||
+		!production.ManualApproval {
+		t.Errorf("unexpected production environment %+v", production)
+	}
+}
+
+type gitHubWorkflow struct {
+	Env  map[string]string
+	Jobs map[string]struct {
+		Needs string
+		If    string
+		Steps []struct {
+			Uses string
+			Run  string
+			With map[string]string
+		}
+	}
+}
+
+func TestGitHubActionsWorkflow(t *testing.T) {
+	expected := map[string][]string{
+		spring.Gradle: {"./gradlew clean build -x test --build-cache --parallel", "./gradlew check --build-cache --parallel"},
+		spring.Maven:  {"./mvnw -B clean package -DskipTests", "./mvnw -B verify"},
+	}
+	for buildTool, commands := range expected {
+		data := renderPipeline(t, spring.GitHubActionsPipeline, buildTool, ".github/workflows/ci.yml")
+		var workflow gitHubWorkflow
+		if err := yaml.Unmarshal(data, &workflow); err != nil {
+			t.Fatalf("%s: invalid YAML: %v\n%s", buildTool, err, data)
+		}
+
+		runs := map[string]bool{}
+		for name, job := range workflow.Jobs {
+			if len(job.Steps) == 0 {
+				t.Errorf("%s: job %s has no steps", buildTool, name)
+			}
+			if _, found := workflow.Jobs[job.Needs]; job.Needs != "" && !found {
+				t.Errorf("%s: job %s needs the unknown job %s", buildTool, name, job.Needs)
+			}
+			for _, step := range job.Steps {
+				if step.Uses == "" && step.Run == "" {
+					t.Errorf("%s: a step of job %s runs nothing", buildTool, name)
+				}
+				runs[step.Run] = true
+			}
+		}
+		for _, job := range []string{"build", "check", "sonar", "pack", "deploy-staging", "deploy-production"} {
+			if _, found := workflow.Jobs[job]; !found {
+				t.Errorf("%s: the workflow misses the job %s", buildTool, job)
+			}
+		}
+		// The other jobs depend on build, so skipping it skips the whole workflow.
+		if condition := workflow.Jobs["build"].If; condition != "${{ github.event_name != 'schedule' }}" {
+			t.Errorf("%s: the excepts are not translated: %q", buildTool, condition)
+		}
+		for _, command := range commands {
+			if !runs[command] {
+				t.Errorf("%s: the workflow does not run %q:\n%s", buildTool, command, data)
+			}
+		}
+	}
+}
+
+func TestGitHubActionsExcepts(t *testing.T) {
+	conditions := map[string]string{
+		"tags":      "${{ !startsWith(github.ref, 'refs/tags/') }}",
+		"branches":  "${{ !startsWith(github.ref, 'refs/heads/') }}",
+		"schedules": "${{ github.event_name != 'schedule' }}",
+		"it's":      "${{ github.ref != 'refs/heads/it''s' }}",
+	}
+	for except, expected := range conditions {
+		data := renderPipelineExcepts(t, spring.GitHubActionsPipeline, spring.Gradle, ".github/workflows/ci.yml", []string{except})
+		var workflow gitHubWorkflow
+		if err := yaml.Unmarshal(data, &workflow); err != nil {
+			t.Fatalf("%s: invalid YAML: %v\n%s", except, err, data)
+		}
+		if condition := workflow.Jobs["build"].If; condition != expected {
+			t.Errorf("%s: expected the condition %q, got %q", except, expected, condition)
+		}
+	}
+
+	data := renderPipelineExcepts(t, spring.GitHubActionsPipeline, spring.Gradle, ".github/workflows/ci.yml",
+		[]string{"tags", "branches"})
+	var workflow gitHubWorkflow
+	if err := yaml.Unmarshal(data, &workflow); err != nil {
+		t.Fatalf("invalid YAML: %v\n%s", err, data)
+	}
+	expected := "${{ !startsWith(github.ref, 'refs/tags/') && !startsWith(github.ref, 'refs/heads/') }}"
+	if condition := workflow.Jobs["build"].If; condition != expected {
+		t.Errorf("expected the condition %q, got %q", expected, condition)
+	}
+}
+
+// renderPipeline generates the CI pipeline of an orders project built by buildTool and returns the file.
+func renderPipeline(t *testing.T, pipeline, buildTool, file string) []byte {
+	return renderPipelineExcepts(t, pipeline, buildTool, file, []string{"schedules"})
+}
+
+// renderPipelineExcepts renders the pipeline like renderPipeline, the jobs are skipped for the excepts.
+func renderPipelineExcepts(t *testing.T, pipeline, buildTool, file string, excepts []string) []byte {
+	root, err := ioutil.TempDir("", "rlctl")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+
+	config := spring.DefaultSpringProjectConfig()
+	config.Name, config.Group, config.BuildTool, config.CIPipeline = "orders", "com.example", buildTool, pipeline
+	config.EnableSonar = true
+	config.GitLabCIConfig.Excepts = excepts
+	if err = spring.ParseAndSaveCiCdFile(root, &config); err != nil {
+		t.Fatal(err)
+	}
+	data, err := ioutil.ReadFile(path.Join(root, file))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+func decodeTekton(t *testing.T, data []byte) []tektonResource {
+	var resources []tektonResource
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	for {
+		var resource tektonResource
+		err := decoder.Decode(&resource)
+		if err == io.EOF {
+			return resources
+		}
+		if err != nil {
+			t.Fatalf("invalid YAML: %v\n%s", err, data)
+		}
+		resources = append(resources, resource)
+	}
+}
@@ -0,0 +1,184 @@
+package spring
+
+import (
+	"context"
+	"github.com/rocketlaunchercloud/rlctl/util"
+	"gopkg.in/yaml.v2"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Preset is a named bundle of SpringProjectConfig defaults, keyed by flag name. Locked settings must keep the value
+// of the preset.
+type Preset struct {
+	Name        string                 `yaml:"name"`
+	Description string                 `yaml:"description,omitempty"`
+	Spring      map[string]interface{} `yaml:"spring"`
+	Locked      []string               `yaml:"locked,omitempty"`
+}
+
+// LoadPreset loads the preset from an http(s) URL, a file or by name from util.PresetDirectory.
+func LoadPreset(ctx context.Context, source string) (*Preset, error) {
+	var data []byte
+	var err error
+	switch {
+	case strings.HasPrefix(source, "http://") || strings.HasPrefix(source, "https://"):
+		data, err = downloadPreset(ctx, source)
+	default:
+		file := source
+		if exists, _ := util.Exists(file); !exists && !strings.ContainsAny(source, `/\`) {
+			dir, err := util.PresetDirectory()
+			if err != nil {
+				return nil, err
+			}
+			file = filepath.Join(dir, source+".yaml")
+		}
+		data, err = ioutil.ReadFile(file)
+		if os.IsNotExist(err) {
+			return nil, util.NewValidationError("preset %s not found", source)
+		}
+		err = util.NewFileSystemError(file, err)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return ParsePreset(source, data)
+}
+
+// ParsePreset parses and validates a preset, source names the preset in errors.
+func ParsePreset(source string, data []byte) (*Preset, error) {
+	preset := &Preset{}
+	if err := yaml.UnmarshalStrict(data, preset); err != nil {
+		return nil, util.NewValidationError("invalid preset %s: %v", source, err)
+	}
+	if preset.Name == "" {
+		preset.Name = source
+	}
+
+	// The defaults must be settings of SpringProjectConfig.
+	spring, err := yaml.Marshal(preset.Spring)
+	if err != nil {
+		return nil, err
+	}
+	var config SpringProjectConfig
+	if err = yaml.UnmarshalStrict(spring, &config); err != nil {
+		return nil, util.NewValidationError("invalid preset %s: %v", preset.Name, err)
+	}
+	for _, key := range preset.Locked {
+		if _, found := preset.Spring[key]; !found {
+			return nil, util.NewValidationError("invalid preset %s: locked setting %s has no value", preset.Name, key)
+		}
+	}
+	return preset, nil
+}
+
+// Apply sets the settings of the preset on the config.
+func (preset *Preset) Apply(config *SpringProjectConfig) error {
+	data, err := yaml.Marshal(preset.Spring)
+	if err != nil {
+		return err
+	}
+	if err = yaml.Unmarshal(data, config); err != nil {
+		return util.NewValidationError("invalid preset %s: %v", preset.Name, err)
+	}
+	return nil
+}
+
+// IsLocked reports whether the setting is locked by the preset.
+func (preset *Preset) IsLocked(key string) bool {
+	for _, locked := range preset.Locked {
+		if locked == key {
+			return true
+		}
+	}
+	return false
+}
+
+// Violations returns a message for every locked setting the config does not keep. The locked values are applied to
+// the config first, values are compared in the types of the config, e.g. 24h and 1440m are the same duration.
+func (preset *Preset) Violations(config SpringProjectConfig) ([]string, error) {
+	values, err := configValues(config)
+	if err != nil {
+		return nil, err
+	}
+	locked := config
+	if err = preset.Apply(&locked); err != nil {
+		return nil, err
+	}
+	lockedValues, err := configValues(locked)
+	if err != nil {
+		return nil, err
+	}
+
+	var violations []string
+	for _, key := range preset.Locked {
+		expected, actual := util.FlagString(preset.Spring[key]), util.FlagString(values[key])
+		if value, found := lockedValues[key]; found {
+			expected = util.FlagString(value)
+		}
+		if expected != actual {
+			violations = append(violations, key+" is locked to "+expected+" by preset "+preset.Name+", got "+actual)
+		}
+	}
+	sort.Strings(violations)
+	return violations, nil
+}
+
+// configValues returns the settings of the config keyed by flag name. Durations are marshalled as nanoseconds, they
+// are formatted like the flags instead.
+func configValues(config SpringProjectConfig) (map[string]interface{}, error) {
+	data, err := yaml.Marshal(config)
+	if err != nil {
+		return nil, err
+	}
+	values := map[string]interface{}{}
+	if err = yaml.Unmarshal(data, &values); err != nil {
+		return nil, err
+	}
+	formatDurations(reflect.ValueOf(config), values)
+	return values, nil
+}
+
+// formatDurations replaces the time.Duration fields of the struct and its inlined structs in values.
+func formatDurations(value reflect.Value, values map[string]interface{}) {
+	for i := 0; i < value.NumField(); i++ {
+		field, tag := value.Field(i), strings.Split(value.Type().Field(i).Tag.Get("yaml"), ",")
+		switch {
+		case len(tag) > 1 && tag[1] == "inline" && field.Kind() == reflect.Struct:
+			formatDurations(field, values)
+		case field.Type() == reflect.TypeOf(time.Duration(0)) && tag[0] != "":
+			values[tag[0]] = time.Duration(field.Int()).String()
+		}
+	}
+}
+
+// Check returns a validation error listing all violations of the preset.
+func (preset *Preset) Check(config SpringProjectConfig) error {
+	violations, err := preset.Violations(config)
+	if err != nil || len(violations) == 0 {
+		return err
+	}
+	return util.NewValidationError("preset violations:\n  %s", strings.Join(violations, "\n  "))
+}
+
+func downloadPreset(ctx context.Context, source string) ([]byte, error) {
+	request, err := http.NewRequest("GET", source, nil)
+	if err != nil {
+		return nil, util.NewValidationError("invalid preset url %s: %v", source, err)
+	}
+	request = request.WithContext(ctx)
+	ch := make(chan util.ChannelResponse)
+	defer close(ch)
+	go util.MakeHttpRequest(request, ch)
+	channelResponse := <-ch
+	if !channelResponse.Success {
+		return nil, channelResponse.Error
+	}
+	return channelResponse.Data, nil
+}
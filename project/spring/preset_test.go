@@ -0,0 +1,113 @@
+package spring_test
+
+import (
+	"context"
+	"github.com/rocketlaunchercloud/rlctl/project/spring"
+	"github.com/rocketlaunchercloud/rlctl/util"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path"
+	"strings"
+	"testing"
+	"time"
+)
+
+const platformPreset = `
+name: platform-default
+spring:
+  container-registry: registry.example.com
+  gitlab-ci-tags: [docker, k8s]
+  sonar-host: https://sonar.example.com
+locked:
+  - container-registry
+  - gitlab-ci-tags
+`
+
+func TestLoadPreset(t *testing.T) {
+	dir, err := ioutil.TempDir("", "rlctl-presets")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	os.Setenv(util.PresetDirectoryEnv, dir)
+	defer os.Unsetenv(util.PresetDirectoryEnv)
+	if err = ioutil.WriteFile(path.Join(dir, "platform-default.yaml"), []byte(platformPreset), 0644); err != nil {
+		t.Fatal(err)
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(platformPreset))
+	}))
+	defer server.Close()
+
+	for _, source := range []string{"platform-default", path.Join(dir, "platform-default.yaml"), server.URL} {
+		preset, err := spring.LoadPreset(context.Background(), source)
+		if err != nil {
+			t.Fatal(source, err)
+		}
+		if preset.Name != "platform-default" || !preset.IsLocked("gitlab-ci-tags") {
+			t.Errorf("%s: unexpected preset %v", source, preset)
+		}
+	}
+	if _, err = spring.LoadPreset(context.Background(), "unknown"); util.KindOf(err) != util.ValidationError {
+		t.Error("expected a validation error, got", err)
+	}
+
+	invalid := map[string]string{
+		"unknown setting": "spring:\n  container-registy: registry.example.com\n",
+		"locked no value": "spring:\n  sonar-host: https://sonar.example.com\nlocked: [container-registry]\n",
+	}
+	for name, data := range invalid {
+		if _, err = spring.ParsePreset(name, []byte(data)); util.KindOf(err) != util.ValidationError {
+			t.Errorf("%s: expected a validation error, got %v", name, err)
+		}
+	}
+}
+
+func TestPresetViolations(t *testing.T) {
+	preset, err := spring.ParsePreset("platform", []byte(platformPreset))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	config := spring.DefaultSpringProjectConfig()
+	if err = preset.Apply(&config); err != nil {
+		t.Fatal(err)
+	}
+	if config.SonarQubeConfig.SonarHost != "https://sonar.example.com" {
+		t.Error("the preset was not applied")
+	}
+	if err = preset.Check(config); err != nil {
+		t.Error(err)
+	}
+
+	config.DockerConfig.RegistryUrl = "docker.io"
+	config.SonarQubeConfig.SonarHost = "https://sonar.local"
+	violations, err := preset.Violations(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(violations) != 1 || !strings.HasPrefix(violations[0], "container-registry is locked") {
+		t.Errorf("unexpected violations %v", violations)
+	}
+	if err = preset.Check(config); util.KindOf(err) != util.ValidationError {
+		t.Error("expected a validation error, got", err)
+	}
+
+	// Durations are compared as durations, not as they are written
+	preset = &spring.Preset{Name: "cache", Spring: map[string]interface{}{"cache-ttl": "1440m"}, Locked: []string{"cache-ttl"}}
+	config = spring.DefaultSpringProjectConfig()
+	config.CacheTTL = 24 * time.Hour
+	if violations, err = preset.Violations(config); err != nil || len(violations) != 0 {
+		t.Errorf("unexpected violations %v %v", violations, err)
+	}
+	config.CacheTTL = time.Hour
+	violations, err = preset.Violations(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(violations) != 1 || violations[0] != "cache-ttl is locked to 24h0m0s by preset cache, got 1h0m0s" {
+		t.Errorf("unexpected violations %v", violations)
+	}
+}
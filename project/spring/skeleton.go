@@ -0,0 +1,231 @@
+package spring
+
+import (
+	"fmt"
+	"github.com/rocketlaunchercloud/rlctl/util"
+	"io/ioutil"
+	"os"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const (
+	GradleWrapperVersion = "6.2.2"
+
+	skeletonTemplatePath = "spring/skeleton"
+	gradleWrapperJarPath = "gradle/wrapper/gradle-wrapper.jar"
+	// gradleWrapperJarTemplate is the bundled jar of GradleWrapperVersion.
+	gradleWrapperJarTemplate = "gradle/gradle-wrapper.jar"
+	mavenWrapperJarPath      = ".mvn/wrapper/maven-wrapper.jar"
+)
+
+var (
+	// initializrArtifacts are the dependencies Spring Initializr adds to Gradle builds for its dependency ids.
+	initializrArtifacts = map[string][]gradleDependency{
+		"web":                    {{"implementation", "org.springframework.boot:spring-boot-starter-web"}},
+		"actuator":               {{"implementation", "org.springframework.boot:spring-boot-starter-actuator"}},
+		"data-jpa":               {{"implementation", "org.springframework.boot:spring-boot-starter-data-jpa"}},
+		"mysql":                  {{"runtimeOnly", "mysql:mysql-connector-java"}},
+		"postgresql":             {{"runtimeOnly", "org.postgresql:postgresql"}},
+		"sqlserver":              {{"runtimeOnly", "com.microsoft.sqlserver:mssql-jdbc"}},
+		"oracle":                 {{"runtimeOnly", "com.oracle.ojdbc:ojdbc8"}},
+		"h2":                     {{"runtimeOnly", "com.h2database:h2"}},
+		"liquibase":              {{"implementation", "org.liquibase:liquibase-core"}},
+		"oauth2-client":          {{"implementation", "org.springframework.boot:spring-boot-starter-oauth2-client"}},
+		"oauth2-resource-server": {{"implementation", "org.springframework.boot:spring-boot-starter-oauth2-resource-server"}},
+		"security": {
+			{"implementation", "org.springframework.boot:spring-boot-starter-security"},
+			{"testImplementation", "org.springframework.security:spring-security-test"},
+		},
+		"kafka": {
+			{"implementation", "org.springframework.kafka:spring-kafka"},
+			{"testImplementation", "org.springframework.kafka:spring-kafka-test"},
+		},
+	}
+	nonIdentifierCharacters = regexp.MustCompile("[^A-Za-z0-9_.]")
+	wordSeparators          = regexp.MustCompile("[^A-Za-z0-9]+")
+)
+
+type skeletonTemplateData struct {
+	*SpringProjectConfig
+	PackageName     string
+	ApplicationName string
+	GradleVersion   string
+	KotlinJvmTarget string
+	// Dependencies are the lines of the dependencies block by configuration, see skeletonDependencies.
+	Dependencies map[string][]string
+}
+
+type skeletonFile struct {
+	template   string
+	target     string
+	executable bool
+}
+
+// generateSpringProjectOffline renders the same project structure Spring Initializr creates from the bundled templates.
+func generateSpringProjectOffline(config *SpringProjectConfig) (string, error) {
+	if config.BuildTool != Gradle {
+		return "", util.NewValidationError("offline generation supports %s only", Gradle)
+	}
+
+	wrapperJar, err := gradleWrapperJar()
+	if err != nil {
+		return "", err
+	}
+
+	data := skeletonTemplateData{
+		SpringProjectConfig: config,
+		PackageName:         packageName(config),
+		ApplicationName:     applicationName(config),
+		GradleVersion:       GradleWrapperVersion,
+		KotlinJvmTarget:     kotlinJvmTarget(config.JavaSourceCompatibility),
+	}
+	buildFile := "build.gradle"
+	if config.Language == Kotlin {
+		buildFile += ".kts"
+	}
+	if data.Dependencies, err = skeletonDependencies(config, dslOf(buildFile)); err != nil {
+		return "", err
+	}
+	packagePath := strings.Replace(data.PackageName, ".", "/", -1)
+
+	files := []skeletonFile{
+		{template: "gradle/gradlew", target: "gradlew", executable: true},
+		{template: "gradle/gradlew.bat", target: "gradlew.bat"},
+		{template: "gradle/gradle-wrapper.properties", target: "gradle/wrapper/gradle-wrapper.properties"},
+		{template: "application.properties", target: "src/main/resources/application.properties"},
+		{template: "HELP.md", target: "HELP.md"},
+	}
+	switch config.Language {
+	case Kotlin:
+		files = append(files,
+			skeletonFile{template: "kotlin/build.gradle.kts", target: "build.gradle.kts"},
+			skeletonFile{template: "kotlin/settings.gradle.kts", target: "settings.gradle.kts"},
+			skeletonFile{template: "kotlin/Application.kt", target: path.Join("src/main/kotlin", packagePath, data.ApplicationName+".kt")},
+			skeletonFile{template: "kotlin/ApplicationTests.kt", target: path.Join("src/test/kotlin", packagePath, data.ApplicationName+"Tests.kt")},
+		)
+		if config.Packaging == War {
+			files = append(files, skeletonFile{template: "kotlin/ServletInitializer.kt", target: path.Join("src/main/kotlin", packagePath, "ServletInitializer.kt")})
+		}
+	case Groovy:
+		files = append(files,
+			skeletonFile{template: "groovy/build.gradle", target: "build.gradle"},
+			skeletonFile{template: "groovy/settings.gradle", target: "settings.gradle"},
+			skeletonFile{template: "groovy/Application.groovy", target: path.Join("src/main/groovy", packagePath, data.ApplicationName+".groovy")},
+			skeletonFile{template: "groovy/ApplicationTests.groovy", target: path.Join("src/test/groovy", packagePath, data.ApplicationName+"Tests.groovy")},
+		)
+		if config.Packaging == War {
+			files = append(files, skeletonFile{template: "groovy/ServletInitializer.groovy", target: path.Join("src/main/groovy", packagePath, "ServletInitializer.groovy")})
+		}
+	case Java, "":
+		files = append(files,
+			skeletonFile{template: "java/build.gradle", target: "build.gradle"},
+			skeletonFile{template: "java/settings.gradle", target: "settings.gradle"},
+			skeletonFile{template: "java/Application.java", target: path.Join("src/main/java", packagePath, data.ApplicationName+".java")},
+			skeletonFile{template: "java/ApplicationTests.java", target: path.Join("src/test/java", packagePath, data.ApplicationName+"Tests.java")},
+		)
+		if config.Packaging == War {
+			files = append(files, skeletonFile{template: "java/ServletInitializer.java", target: path.Join("src/main/java", packagePath, "ServletInitializer.java")})
+		}
+	default:
+		return "", util.NewValidationError("offline generation does not support %s", config.Language)
+	}
+
+	projectRoot := config.OutputDirectory
+	for _, file := range files {
+		templateStr, err := util.GetSpringTemplate(path.Join(skeletonTemplatePath, file.template))
+		if err != nil {
+			return "", err
+		}
+		parsedTemplate, err := util.ParseTemplate(data, file.target, templateStr)
+		if err != nil {
+			return "", err
+		}
+
+		filePath := path.Join(projectRoot, file.target)
+		if err = os.MkdirAll(path.Dir(filePath), os.ModePerm); err != nil {
+			return "", err
+		}
+		mode := os.FileMode(0644)
+		if file.executable {
+			mode = 0755
+		}
+		if err = ioutil.WriteFile(filePath, []byte(parsedTemplate), mode); err != nil {
+			return "", err
+		}
+	}
+
+	return projectRoot, ioutil.WriteFile(path.Join(projectRoot, gradleWrapperJarPath), wrapperJar, 0644)
+}
+
+// gradleWrapperJar returns the bundled Gradle wrapper jar. Builds without the bundled jar reuse the jar of an earlier
+// Initializr download, gradlew cannot run without it.
+func gradleWrapperJar() ([]byte, error) {
+	if wrapperJar, err := util.GetSpringFile(path.Join(skeletonTemplatePath, gradleWrapperJarTemplate)); err == nil {
+		return wrapperJar, nil
+	}
+	wrapperJar, cached, err := util.GetCached(gradleWrapperJarPath, 0)
+	if err != nil {
+		return nil, err
+	}
+	if !cached {
+		return nil, util.NewValidationError("%s is neither bundled nor cached, generate a project online once or "+
+			"build rlctl with templates/%s/%s", gradleWrapperJarPath, skeletonTemplatePath, gradleWrapperJarTemplate)
+	}
+	return wrapperJar, nil
+}
+
+// skeletonDependencies renders the dependencies of InitializrDependencies by configuration, e.g. runtimeOnly, offline
+// projects get the same dependencies as downloaded ones.
+func skeletonDependencies(config *SpringProjectConfig, dsl gradleDSL) (map[string][]string, error) {
+	lines := map[string][]string{}
+	for _, id := range InitializrDependencies(config) {
+		artifacts, found := initializrArtifacts[id]
+		if !found {
+			return nil, util.NewValidationError("offline generation does not support the dependency %s", id)
+		}
+		for _, artifact := range artifacts {
+			lines[artifact.configuration] = append(lines[artifact.configuration], artifact.line(dsl))
+		}
+	}
+	return lines, nil
+}
+
+// kotlinJvmTarget returns the jvmTarget of the Kotlin compiler for the Java version, which names Java 8 and older
+// 1.<version>.
+func kotlinJvmTarget(javaVersion string) string {
+	if version, err := strconv.Atoi(javaVersion); err == nil && version <= 8 {
+		return "1." + javaVersion
+	}
+	if javaVersion == "" {
+		return "1.8"
+	}
+	return javaVersion
+}
+
+// packageName returns the configured base package or derives it the same way Spring Initializr does, from the group
+// and the artifact.
+func packageName(config *SpringProjectConfig) string {
+	if config.PackageName != "" {
+		return config.PackageName
+	}
+	name := fmt.Sprintf("%s.%s", config.Group, config.Name)
+	return strings.ToLower(nonIdentifierCharacters.ReplaceAllString(name, ""))
+}
+
+// applicationName derives the name of the application class the same way Spring Initializr does, e.g.
+// my-service becomes MyServiceApplication.
+func applicationName(config *SpringProjectConfig) string {
+	var name string
+	for _, word := range wordSeparators.Split(config.Name, -1) {
+		if len(word) > 0 {
+			name += strings.ToUpper(word[:1]) + word[1:]
+		}
+	}
+	if name == "" {
+		name = "Demo"
+	}
+	return name + "Application"
+}
@@ -0,0 +1,213 @@
+package spring_test
+
+import (
+	"context"
+	"github.com/rocketlaunchercloud/rlctl/project/spring"
+	"github.com/rocketlaunchercloud/rlctl/util"
+	"io/ioutil"
+	"os"
+	"path"
+	"strings"
+	"testing"
+)
+
+// TestMain caches a Gradle wrapper jar in a temporary cache directory, offline generation requires one and the tests
+// must not use the cache of the user.
+func TestMain(m *testing.M) {
+	cache, err := ioutil.TempDir("", "rlctl-cache")
+	if err != nil {
+		panic(err)
+	}
+	os.Setenv(util.CacheDirectoryEnv, cache)
+	if err = util.PutCached("gradle/wrapper/gradle-wrapper.jar", []byte("JAR")); err != nil {
+		panic(err)
+	}
+	code := m.Run()
+	os.RemoveAll(cache)
+	os.Exit(code)
+}
+
+func TestGenerateOffline(t *testing.T) {
+	root, err := ioutil.TempDir("", "rlctl")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+
+	tests := []struct {
+		language    string
+		javaVersion string
+		expected    map[string][]string
+	}{
+		{
+			language:    spring.Java,
+			javaVersion: "11",
+			expected: map[string][]string{
+				"build.gradle":    {"group = 'com.example'", "sourceCompatibility = '11'", "id 'war'"},
+				"settings.gradle": {"rootProject.name = 'order-service'"},
+				"src/main/java/com/example/orderservice/OrderServiceApplication.java": {
+					"package com.example.orderservice;", "public class OrderServiceApplication {"},
+				"src/test/java/com/example/orderservice/OrderServiceApplicationTests.java": {"class OrderServiceApplicationTests {"},
+				"src/main/java/com/example/orderservice/ServletInitializer.java":           {"OrderServiceApplication.class"},
+			},
+		},
+		{
+			language:    spring.Kotlin,
+			javaVersion: "8",
+			expected: map[string][]string{
+				"build.gradle.kts":    {"group = \"com.example\"", "jvmTarget = \"1.8\"", "\twar\n"},
+				"settings.gradle.kts": {"rootProject.name = \"order-service\""},
+				"src/main/kotlin/com/example/orderservice/OrderServiceApplication.kt": {
+					"package com.example.orderservice", "class OrderServiceApplication"},
+				"src/test/kotlin/com/example/orderservice/OrderServiceApplicationTests.kt": {"class OrderServiceApplicationTests {"},
+				"src/main/kotlin/com/example/orderservice/ServletInitializer.kt":           {"OrderServiceApplication::class.java"},
+			},
+		},
+		{
+			language:    spring.Kotlin,
+			javaVersion: "11",
+			expected: map[string][]string{
+				"build.gradle.kts": {"jvmTarget = \"11\""},
+			},
+		},
+		{
+			language:    spring.Groovy,
+			javaVersion: "11",
+			expected: map[string][]string{
+				"build.gradle":    {"id 'groovy'", "id 'war'"},
+				"settings.gradle": {"rootProject.name = 'order-service'"},
+				"src/main/groovy/com/example/orderservice/OrderServiceApplication.groovy": {
+					"package com.example.orderservice", "class OrderServiceApplication {"},
+				"src/test/groovy/com/example/orderservice/OrderServiceApplicationTests.groovy": {"class OrderServiceApplicationTests {"},
+				"src/main/groovy/com/example/orderservice/ServletInitializer.groovy":           {"OrderServiceApplication"},
+			},
+		},
+	}
+
+	for i, test := range tests {
+		config := spring.DefaultSpringProjectConfig()
+		config.Name, config.Group, config.Offline = "order-service", "com.example", true
+		config.Language, config.JavaSourceCompatibility, config.Packaging = test.language, test.javaVersion, spring.War
+		config.OutputDirectory = path.Join(root, test.language+test.javaVersion)
+		if _, err = spring.Generate(context.Background(), &config, spring.FailPolicy, nil); err != nil {
+			t.Fatal(err)
+		}
+
+		test.expected["gradle/wrapper/gradle-wrapper.properties"] = []string{"gradle-" + spring.GradleWrapperVersion + "-bin.zip"}
+		test.expected["src/main/resources/application.properties"] = nil
+		for file, expected := range test.expected {
+			data, err := ioutil.ReadFile(path.Join(config.OutputDirectory, file))
+			if err != nil {
+				t.Errorf("test %d: %v", i, err)
+				continue
+			}
+			for _, content := range expected {
+				if !strings.Contains(string(data), content) {
+					t.Errorf("%s of the %s project misses %q:\n%s", file, test.language, content, data)
+				}
+			}
+		}
+		info, err := os.Stat(path.Join(config.OutputDirectory, "gradlew"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if info.Mode().Perm()&0100 == 0 {
+			t.Errorf("gradlew of the %s project is not executable: %v", test.language, info.Mode())
+		}
+	}
+
+	config := spring.DefaultSpringProjectConfig()
+	config.Name, config.Group, config.Offline, config.BuildTool = "orders", "com.example", true, spring.Maven
+	config.OutputDirectory = path.Join(root, "maven")
+	if _, err = spring.Generate(context.Background(), &config, spring.FailPolicy, nil); util.KindOf(err) != util.ValidationError {
+		t.Errorf("expected a validation error, got %v", err)
+	}
+}
+
+func TestGenerateOfflineWithoutWrapperJar(t *testing.T) {
+	root, err := ioutil.TempDir("", "rlctl")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+	defer os.Setenv(util.CacheDirectoryEnv, os.Getenv(util.CacheDirectoryEnv))
+	os.Setenv(util.CacheDirectoryEnv, path.Join(root, "cache"))
+
+	config := spring.DefaultSpringProjectConfig()
+	config.Name, config.Group, config.Offline = "orders", "com.example", true
+	config.OutputDirectory = path.Join(root, "orders")
+	if _, err = spring.Generate(context.Background(), &config, spring.FailPolicy, nil); util.KindOf(err) != util.ValidationError {
+		t.Fatalf("expected a validation error, got %v", err)
+	}
+	if _, err = os.Stat(config.OutputDirectory); !os.IsNotExist(err) {
+		t.Errorf("expected no project without the wrapper jar, got %v", err)
+	}
+}
+
+func TestGenerateOfflineDependencies(t *testing.T) {
+	root, err := ioutil.TempDir("", "rlctl")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+
+	// The modules Spring Initializr adds to Gradle builds for its dependency ids
+	modules := map[string][]string{
+		"web":                    {"org.springframework.boot:spring-boot-starter-web"},
+		"actuator":               {"org.springframework.boot:spring-boot-starter-actuator"},
+		"data-jpa":               {"org.springframework.boot:spring-boot-starter-data-jpa"},
+		"mysql":                  {"mysql:mysql-connector-java"},
+		"postgresql":             {"org.postgresql:postgresql"},
+		"sqlserver":              {"com.microsoft.sqlserver:mssql-jdbc"},
+		"oracle":                 {"com.oracle.ojdbc:ojdbc8"},
+		"h2":                     {"com.h2database:h2"},
+		"liquibase":              {"org.liquibase:liquibase-core"},
+		"security":               {"org.springframework.boot:spring-boot-starter-security", "org.springframework.security:spring-security-test"},
+		"oauth2-client":          {"org.springframework.boot:spring-boot-starter-oauth2-client"},
+		"oauth2-resource-server": {"org.springframework.boot:spring-boot-starter-oauth2-resource-server"},
+		"kafka":                  {"org.springframework.kafka:spring-kafka", "org.springframework.kafka:spring-kafka-test"},
+	}
+
+	configs := map[string]func(config *spring.SpringProjectConfig){
+		"defaults": func(config *spring.SpringProjectConfig) {},
+		"features": func(config *spring.SpringProjectConfig) {
+			config.JpaDatabase, config.EnableLiquibase, config.EnableSecurity = "POSTGRESQL", true, true
+			config.EnableOAuth2, config.EnableKafka = true, true
+		},
+		"minimal": func(config *spring.SpringProjectConfig) {
+			config.EnableJPA = false
+		},
+	}
+	buildFiles := map[string]string{spring.Java: "build.gradle", spring.Kotlin: "build.gradle.kts", spring.Groovy: "build.gradle"}
+	for name, configure := range configs {
+		for language, buildFile := range buildFiles {
+			config := spring.DefaultSpringProjectConfig()
+			config.Name, config.Group, config.Offline, config.Language = "orders", "com.example", true, language
+			config.OutputDirectory = path.Join(root, name+"-"+language)
+			configure(&config)
+			if _, err = spring.Generate(context.Background(), &config, spring.FailPolicy, nil); err != nil {
+				t.Fatal(err)
+			}
+			data, err := ioutil.ReadFile(path.Join(config.OutputDirectory, buildFile))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			online := map[string]bool{}
+			for _, id := range spring.InitializrDependencies(&config) {
+				if _, found := modules[id]; !found {
+					t.Errorf("unknown Initializr dependency %s", id)
+				}
+				online[id] = true
+			}
+			for id, idModules := range modules {
+				for _, module := range idModules {
+					if found := strings.Contains(string(data), module); found != online[id] {
+						t.Errorf("%s %s project: %s is in the build file %t, requested from Initializr %t", name,
+							language, module, found, online[id])
+					}
+				}
+			}
+		}
+	}
+}
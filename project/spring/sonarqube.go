@@ -10,11 +10,11 @@ import (
 )
 
 type SonarQube struct {
-	SonarHost                string
-	SonarLogin               string
-	SonarUserToken           string
-	SonarVersion             string
-	SonarQualityGateFailMode string //https://github.com/gabrie-allaigre/sonar-gitlab-plugin -> error, warn or none
+	SonarHost                string `yaml:"sonar-host" json:"sonar-host"`
+	SonarLogin               string `yaml:"sonar-login" json:"sonar-login"`
+	SonarUserToken           string `yaml:"-" json:"sonar-user-token"`
+	SonarVersion             string `yaml:"sonar-version" json:"sonar-version"`
+	SonarQualityGateFailMode string `yaml:"sonar-quality_gate_fail_mode" json:"sonar-quality_gate_fail_mode"` //https://github.com/gabrie-allaigre/sonar-gitlab-plugin -> error, warn or none
 }
 
 var (
@@ -36,22 +36,35 @@ var (
 	}
 )
 
+// SourceExtension returns the file extension of the sources of the project language, e.g. for the Sonar exclusions.
+func (config *SpringProjectConfig) SourceExtension() string {
+	switch config.Language {
+	case Kotlin:
+		return "kt"
+	case Groovy:
+		return "groovy"
+	default:
+		return "java"
+	}
+}
+
 func AddSonarFlagsToCommand(cmd *cobra.Command) {
-	cmd.Flags().StringP(sonarHost, "", "", defaultSonarQubeInstance.SonarHost)
+	cmd.Flags().StringP(sonarHost, "", defaultSonarQubeInstance.SonarHost, "SonarQube host URL")
 	cmd.Flags().StringP(sonarUserToken, "", defaultSonarQubeInstance.SonarUserToken, "SonarQuebe user token")
 	cmd.Flags().StringP(sonarLogin, "", defaultSonarQubeInstance.SonarLogin, "SonarQuebe login")
 	cmd.Flags().StringP(sonarVersion, "", defaultSonarQubeInstance.SonarVersion, "SonarQuebe library version")
 	cmd.Flags().StringP(sonarQualityGateFailMode, "", defaultSonarQubeInstance.SonarQualityGateFailMode, "quality_gate_fail_mode (https://github.com/gabrie-allaigre/sonar-gitlab-plugin)")
 }
 
-func CreateSonarInstanceFromCommandFlags(cmd *cobra.Command) SonarQube {
+func CreateSonarInstanceFromCommandFlags(cmd *cobra.Command) (SonarQube, error) {
+	flags := util.NewFlagReader(cmd)
 	var sonar = SonarQube{}
-	sonar.SonarHost = util.GetValue(cmd, sonarHost)
-	sonar.SonarLogin = util.GetValue(cmd, sonarLogin)
-	sonar.SonarUserToken = util.GetValue(cmd, sonarUserToken)
-	sonar.SonarVersion = util.GetValue(cmd, sonarVersion)
-	sonar.SonarQualityGateFailMode = util.GetValue(cmd, sonarQualityGateFailMode)
-	return sonar
+	sonar.SonarHost = flags.Value(sonarHost)
+	sonar.SonarLogin = flags.Value(sonarLogin)
+	sonar.SonarUserToken = flags.Value(sonarUserToken)
+	sonar.SonarVersion = flags.Value(sonarVersion)
+	sonar.SonarQualityGateFailMode = flags.Value(sonarQualityGateFailMode)
+	return sonar, flags.Err()
 }
 
 func ParseAndSaveSonarQubeFile(projectRoot string, templateData *SpringProjectConfig) (string, error) {
@@ -1,64 +1,232 @@
 package spring
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
 	"github.com/rocketlaunchercloud/rlctl/util"
 	"io/ioutil"
+	"log"
 	"net/http"
+	"net/url"
 	"os"
 	"path"
+	"sort"
+	"strings"
+	"time"
 )
 
 const (
-	Maven                        = "maven-project"
-	Gradle                       = "gradle-project"
-	Java                         = "java"
-	Kotlin                       = "kotlin"
-	SpringBootLatestVersion      = "2.2.5.RELEASE"
-	springInitializerUrlTemplate = "spring.initializr.tmpl"
+	Maven                       = "maven-project"
+	Gradle                      = "gradle-project"
+	Java                        = "java"
+	Kotlin                      = "kotlin"
+	Groovy                      = "groovy"
+	Jar                         = "jar"
+	War                         = "war"
+	SpringBootLatestVersion     = "2.2.5.RELEASE"
+	DefaultInitializrUrl        = "https://start.spring.io"
+	initializrMetadataMediaType = "application/vnd.initializr.v2.1+json"
 )
 
-func GenerateSpringProject(config *SpringProjectConfig) (string, error) {
-	springTemplate, err := util.GetSpringTemplate(springInitializerUrlTemplate)
-	if err != nil {
-		return "", err
+var (
+	wrapperArtifacts = []string{gradleWrapperJarPath, mavenWrapperJarPath}
+
+	// Initializr dependency ids of the drivers for the supported JPA databases
+	jpaDatabaseDependencies = map[string]string{
+		"MYSQL":      "mysql",
+		"POSTGRESQL": "postgresql",
+		"SQL_SERVER": "sqlserver",
+		"ORACLE":     "oracle",
+		"H2":         "h2",
 	}
-	url, err := util.ParseTemplate(config, springInitializerUrlTemplate, springTemplate)
-	if err != nil {
-		return "", err
+)
+
+type InitializrMetadata struct {
+	BootVersion  InitializrSingleSelect     `json:"bootVersion"`
+	Packaging    InitializrSingleSelect     `json:"packaging"`
+	JavaVersion  InitializrSingleSelect     `json:"javaVersion"`
+	Language     InitializrSingleSelect     `json:"language"`
+	Type         InitializrSingleSelect     `json:"type"`
+	Dependencies InitializrDependencyGroups `json:"dependencies"`
+}
+
+type InitializrSingleSelect struct {
+	Default string             `json:"default"`
+	Values  []InitializrOption `json:"values"`
+}
+
+type InitializrDependencyGroups struct {
+	Values []InitializrDependencyGroup `json:"values"`
+}
+
+type InitializrDependencyGroup struct {
+	Name   string             `json:"name"`
+	Values []InitializrOption `json:"values"`
+}
+
+type InitializrOption struct {
+	Id           string `json:"id"`
+	Name         string `json:"name"`
+	Description  string `json:"description,omitempty"`
+	VersionRange string `json:"versionRange,omitempty"`
+}
+
+func GenerateSpringProject(ctx context.Context, config *SpringProjectConfig) (string, error) {
+	if config.Offline {
+		return generateSpringProjectOffline(config)
 	}
 
-	_, err = downloadAndUnzip(&url)
+	downloadUrl := InitializrUrl(config)
+	projectRoot := config.OutputDirectory
+	_, err := downloadAndUnzip(ctx, &downloadUrl, projectRoot, config.CacheTTL)
 	if err != nil {
 		return "", err
 	}
 
-	return path.Join(util.OutputDirectory, (*config).Name), nil
+	cacheWrapperArtifacts(projectRoot)
+
+	return projectRoot, nil
+}
+
+// InitializrUrl returns the url of the Initializr archive of the project, every parameter is query escaped.
+func InitializrUrl(config *SpringProjectConfig) string {
+	query := url.Values{}
+	query.Set("type", config.BuildTool)
+	query.Set("language", config.Language)
+	query.Set("bootVersion", config.SpringBootVersion)
+	query.Set("groupId", config.Group)
+	query.Set("artifactId", config.Name)
+	query.Set("name", config.Name)
+	query.Set("description", config.Description)
+	query.Set("packageName", packageName(config))
+	query.Set("packaging", config.Packaging)
+	query.Set("javaVersion", config.JavaSourceCompatibility)
+	query.Set("dependencies", strings.Join(InitializrDependencies(config), ","))
+	return fmt.Sprintf("%s/starter.zip?%s", strings.TrimSuffix(config.InitializrUrl, "/"), query.Encode())
 }
 
-func downloadAndUnzip(downloadUrl *string) ([]string, error) {
+// downloadAndUnzip extracts the archive of the url into the project root. Archives are served from the cache
+// while they are younger than ttl, a negative ttl disables the cache.
+func downloadAndUnzip(ctx context.Context, downloadUrl *string, projectRoot string, ttl time.Duration) ([]string, error) {
+	if ttl >= 0 {
+		data, cached, err := util.GetCached(*downloadUrl, ttl)
+		if err != nil {
+			log.Printf("Unable to read the cache: %v\n", err)
+		}
+		if cached {
+			log.Printf("Using cached %s\n", *downloadUrl)
+			return unzip(data, projectRoot)
+		}
+	}
+
 	request, err := http.NewRequest("GET", *downloadUrl, nil)
 	if err != nil {
 		return nil, err
 	}
+	request = request.WithContext(ctx)
 	ch := make(chan util.ChannelResponse)
 	defer close(ch)
 	go util.MakeHttpRequest(request, ch)
 	channelResponse := <-ch
-	if channelResponse.Success {
-		fileName, err := util.GenerateTemporaryFileName()
+	if !channelResponse.Success {
+		return nil, channelResponse.Error
+	}
+
+	files, err := unzip(channelResponse.Data, projectRoot)
+	if err != nil {
+		return nil, err
+	}
+	if ttl >= 0 {
+		if err = util.PutCached(*downloadUrl, channelResponse.Data); err != nil {
+			log.Printf("Unable to cache %s: %v\n", *downloadUrl, err)
+		}
+	}
+	return files, nil
+}
+
+func unzip(data []byte, projectRoot string) ([]string, error) {
+	fileName, err := util.GenerateTemporaryFileName()
+	if err != nil {
+		return nil, err
+	}
+	if err = ioutil.WriteFile(fileName, data, os.ModePerm); err != nil {
+		return nil, err
+	}
+	// Remove the temp file
+	defer os.Remove(fileName)
+
+	return util.Unzip(fileName, projectRoot)
+}
+
+// cacheWrapperArtifacts keeps the wrapper jars Initializr ships, offline generation reuses them.
+func cacheWrapperArtifacts(projectRoot string) {
+	for _, artifact := range wrapperArtifacts {
+		data, err := ioutil.ReadFile(path.Join(projectRoot, artifact))
 		if err != nil {
-			return nil, err
+			continue
 		}
-		if err = ioutil.WriteFile(fileName, channelResponse.Data, os.ModePerm); err != nil {
-			return nil, err
+		if err = util.PutCached(artifact, data); err != nil {
+			log.Printf("Unable to cache %s: %v\n", artifact, err)
+		}
+	}
+}
+
+// Languages lists the supported project languages.
+var Languages = []string{Java, Kotlin, Groovy}
+
+// JpaDatabases lists the supported JPA databases.
+func JpaDatabases() []string {
+	var databases []string
+	for database := range jpaDatabaseDependencies {
+		databases = append(databases, database)
+	}
+	sort.Strings(databases)
+	return databases
+}
+
+// InitializrDependencies computes the Spring Initializr dependency ids of the features enabled for the project.
+func InitializrDependencies(config *SpringProjectConfig) []string {
+	dependencies := []string{"web", "actuator"}
+	if config.EnableJPA {
+		dependencies = append(dependencies, "data-jpa")
+		if driver, ok := jpaDatabaseDependencies[strings.ToUpper(config.JpaDatabase)]; ok {
+			dependencies = append(dependencies, driver)
 		}
-		files, err := util.Unzip(fileName, util.OutputDirectory)
-		// Remove the temp file
-		err = os.Remove(fileName)
-		if err == nil {
-			return nil, err
+	}
+	if config.EnableLiquibase {
+		dependencies = append(dependencies, "liquibase")
+	}
+	if config.EnableSecurity {
+		dependencies = append(dependencies, "security")
+	}
+	if config.EnableOAuth2 {
+		dependencies = append(dependencies, "oauth2-client", "oauth2-resource-server")
+	}
+	if config.EnableKafka {
+		dependencies = append(dependencies, "kafka")
+	}
+	return dependencies
+}
+
+// GetInitializrMetadata reads the boot versions, dependencies and other options the Initializr instance supports.
+func GetInitializrMetadata(initializrUrl string) (*InitializrMetadata, error) {
+	request, err := http.NewRequest("GET", strings.TrimSuffix(initializrUrl, "/"), nil)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("Accept", initializrMetadataMediaType)
+
+	ch := make(chan util.ChannelResponse)
+	defer close(ch)
+	go util.MakeHttpRequest(request, ch)
+	channelResponse := <-ch
+	if channelResponse.Success {
+		metadata := &InitializrMetadata{}
+		if err = json.Unmarshal(channelResponse.Data, metadata); err != nil {
+			return nil, util.NewNetworkError(initializrUrl, fmt.Errorf("no Initializr metadata: %w", err))
 		}
-		return files, err
+		return metadata, nil
 	}
 	return nil, channelResponse.Error
 }
@@ -0,0 +1,50 @@
+package spring_test
+
+import (
+	"github.com/rocketlaunchercloud/rlctl/project/spring"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestInitializrUrl(t *testing.T) {
+	config := spring.DefaultSpringProjectConfig()
+	config.InitializrUrl = "https://initializr.example.com/"
+	config.Name, config.Group = "orders & billing+v2", "com.example&admin=true"
+	config.Description = "Orders & billing, 100% + more"
+	config.EnableJPA, config.JpaDatabase = true, "POSTGRESQL"
+
+	downloadUrl, err := url.Parse(spring.InitializrUrl(&config))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if downloadUrl.Host != "initializr.example.com" || downloadUrl.Path != "/starter.zip" {
+		t.Errorf("unexpected url %s", downloadUrl)
+	}
+
+	query := downloadUrl.Query()
+	expected := map[string]string{
+		"type":         config.BuildTool,
+		"language":     config.Language,
+		"bootVersion":  config.SpringBootVersion,
+		"groupId":      "com.example&admin=true",
+		"artifactId":   "orders & billing+v2",
+		"name":         "orders & billing+v2",
+		"description":  "Orders & billing, 100% + more",
+		"packageName":  "com.exampleadmintrue.ordersbillingv2",
+		"packaging":    config.Packaging,
+		"javaVersion":  config.JavaSourceCompatibility,
+		"dependencies": strings.Join(spring.InitializrDependencies(&config), ","),
+	}
+	for key, value := range expected {
+		if values := query[key]; len(values) != 1 || values[0] != value {
+			t.Errorf("expected %s=%q, got %q", key, value, values)
+		}
+	}
+	if len(query) != len(expected) {
+		t.Errorf("unexpected parameters %v", query)
+	}
+	if !strings.Contains(query.Get("dependencies"), "postgresql") {
+		t.Errorf("expected the dependencies of the enabled features, got %s", query.Get("dependencies"))
+	}
+}
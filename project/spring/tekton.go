@@ -0,0 +1,52 @@
+package spring
+
+import (
+	"fmt"
+	"github.com/rocketlaunchercloud/rlctl/util"
+	"io/ioutil"
+	"log"
+	"os"
+	"path"
+)
+
+var (
+	tektonTasksTemplate    = "buildpipeline/tekton/tasks.yml.tmpl"
+	tektonPipelineTemplate = "buildpipeline/tekton/pipeline.yml.tmpl"
+	tektonPath             = "tekton"
+)
+
+func parseAndSaveTektonFiles(projectRoot string, templateData *SpringProjectConfig) error {
+	data := pipelineTemplateData{
+		SpringProjectConfig: templateData,
+		Pipeline:            NewPipeline(templateData),
+	}
+
+	configPath := path.Join(projectRoot, tektonPath)
+	if err := os.MkdirAll(configPath, os.ModePerm); err != nil {
+		return err
+	}
+
+	if err := saveTektonFile(configPath, tektonTasksTemplate, "tasks.yml", &data); err != nil {
+		return err
+	}
+	return saveTektonFile(configPath, tektonPipelineTemplate, "pipeline.yml", &data)
+}
+
+func saveTektonFile(configPath, templatePath, fileName string, data *pipelineTemplateData) error {
+	templateStr, err := util.GetSpringTemplate(templatePath)
+	if err != nil {
+		return err
+	}
+	parsedTemplate, err := util.ParseTemplate(data, fileName, templateStr)
+	if err != nil {
+		return err
+	}
+
+	filePath := path.Join(configPath, fileName)
+	err = ioutil.WriteFile(filePath, []byte(parsedTemplate), os.ModePerm)
+	if err != nil {
+		return fmt.Errorf("unable to save %s: %w", filePath, err)
+	}
+	log.Printf("%s created successfully!", filePath)
+	return nil
+}
@@ -1,9 +0,0 @@
-rootProject.name = "{{.Name}}"
-
-pluginManagement {
-    repositories {
-        gradlePluginPortal()
-        jcenter()
-        maven (url="https://dl.bintray.com/gradle/gradle-plugins")
-    }
-}
@@ -0,0 +1,13 @@
+package {{.PackageName}}
+
+import org.junit.jupiter.api.Test
+import org.springframework.boot.test.context.SpringBootTest
+
+@SpringBootTest
+class {{.ApplicationName}}Tests {
+
+	@Test
+	void contextLoads() {
+	}
+
+}
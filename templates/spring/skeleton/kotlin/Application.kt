@@ -0,0 +1,11 @@
+package {{.PackageName}}
+
+import org.springframework.boot.autoconfigure.SpringBootApplication
+import org.springframework.boot.runApplication
+
+@SpringBootApplication
+class {{.ApplicationName}}
+
+fun main(args: Array<String>) {
+	runApplication<{{.ApplicationName}}>(*args)
+}
@@ -0,0 +1,44 @@
+import org.jetbrains.kotlin.gradle.tasks.KotlinCompile
+
+plugins {
+	id("org.springframework.boot") version "{{.SpringBootVersion}}"
+	id("io.spring.dependency-management") version "1.0.9.RELEASE"{{if eq .Packaging "war"}}
+	war{{end}}
+	kotlin("jvm") version "1.3.61"
+	kotlin("plugin.spring") version "1.3.61"{{if .EnableJPA}}
+	kotlin("plugin.jpa") version "1.3.61"{{end}}
+}
+
+group = "{{.Group}}"
+version = "{{if .Version}}{{.Version}}{{else}}0.0.1-SNAPSHOT{{end}}"
+java.sourceCompatibility = JavaVersion.toVersion("{{.JavaSourceCompatibility}}")
+
+repositories {
+	mavenCentral()
+}
+
+dependencies {
+{{- range .Dependencies.implementation}}
+	{{.}}
+{{- end}}
+	implementation("com.fasterxml.jackson.module:jackson-module-kotlin")
+	implementation("org.jetbrains.kotlin:kotlin-reflect")
+	implementation("org.jetbrains.kotlin:kotlin-stdlib-jdk8"){{range .Dependencies.runtimeOnly}}
+	{{.}}{{end}}{{if eq .Packaging "war"}}
+	providedRuntime("org.springframework.boot:spring-boot-starter-tomcat"){{end}}
+	testImplementation("org.springframework.boot:spring-boot-starter-test") {
+		exclude(group = "org.junit.vintage", module = "junit-vintage-engine")
+	}{{range .Dependencies.testImplementation}}
+	{{.}}{{end}}
+}
+
+tasks.withType<Test> {
+	useJUnitPlatform()
+}
+
+tasks.withType<KotlinCompile> {
+	kotlinOptions {
+		freeCompilerArgs = listOf("-Xjsr305=strict")
+		jvmTarget = "{{.KotlinJvmTarget}}"
+	}
+}
@@ -0,0 +1,212 @@
+package util
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/mitchellh/go-homedir"
+	"io/ioutil"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	CacheDirectoryEnv = "RLCTL_CACHE_DIR"
+	DefaultCacheTTL   = 24 * time.Hour
+
+	cacheBlobsDirectory   = "blobs"
+	cacheEntriesDirectory = "entries"
+	// cacheTempPrefix starts the files being written, they are ignored when listing and cleaning the cache.
+	cacheTempPrefix = ".tmp-"
+)
+
+// errInvalidCacheEntry is returned for entries without a valid SHA-256 checksum.
+var errInvalidCacheEntry = errors.New("invalid cache entry")
+
+// CacheEntry maps a key, e.g. a rendered Initializr URL, onto a content-addressed blob in the cache.
+type CacheEntry struct {
+	Key       string    `json:"key"`
+	Checksum  string    `json:"sha256"`
+	Size      int64     `json:"size"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// CacheDirectory returns $RLCTL_CACHE_DIR or ~/.cache/rlctl.
+func CacheDirectory() (string, error) {
+	if dir := os.Getenv(CacheDirectoryEnv); dir != "" {
+		return dir, nil
+	}
+	home, err := homedir.Dir()
+	if err != nil {
+		return "", err
+	}
+	return path.Join(home, ".cache", "rlctl"), nil
+}
+
+// GetCached returns the cached data of the key if it is younger than ttl, a zero ttl never expires. Entries whose
+// blob does not match the recorded checksum are evicted and reported as a miss.
+func GetCached(key string, ttl time.Duration) ([]byte, bool, error) {
+	dir, err := CacheDirectory()
+	if err != nil {
+		return nil, false, err
+	}
+	entryPath := path.Join(dir, cacheEntriesDirectory, checksum([]byte(key))+".json")
+	entry, err := readCacheEntry(entryPath)
+	if os.IsNotExist(err) {
+		return nil, false, nil
+	}
+	if err == errInvalidCacheEntry {
+		return nil, false, os.Remove(entryPath)
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	if ttl > 0 && time.Since(entry.CreatedAt) > ttl {
+		return nil, false, nil
+	}
+
+	data, err := ioutil.ReadFile(path.Join(dir, cacheBlobsDirectory, entry.Checksum))
+	if err != nil || checksum(data) != entry.Checksum {
+		return nil, false, os.Remove(entryPath)
+	}
+	return data, true, nil
+}
+
+// PutCached stores the data of the key, the blob is shared by all keys with the same content.
+func PutCached(key string, data []byte) error {
+	dir, err := CacheDirectory()
+	if err != nil {
+		return err
+	}
+	for _, subdirectory := range []string{cacheBlobsDirectory, cacheEntriesDirectory} {
+		if err = os.MkdirAll(path.Join(dir, subdirectory), 0700); err != nil {
+			return err
+		}
+	}
+
+	entry := CacheEntry{Key: key, Checksum: checksum(data), Size: int64(len(data)), CreatedAt: time.Now()}
+	if err = writeCacheFile(path.Join(dir, cacheBlobsDirectory, entry.Checksum), data); err != nil {
+		return err
+	}
+	marshaledEntry, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	return writeCacheFile(path.Join(dir, cacheEntriesDirectory, checksum([]byte(key))+".json"), marshaledEntry)
+}
+
+// writeCacheFile writes the data to a temporary file next to the file and renames it, concurrent readers see the old
+// or the new content but never a partially written file.
+func writeCacheFile(file string, data []byte) error {
+	temp, err := ioutil.TempFile(path.Dir(file), cacheTempPrefix)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(temp.Name())
+	if _, err = temp.Write(data); err != nil {
+		temp.Close()
+		return err
+	}
+	if err = temp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(temp.Name(), file)
+}
+
+// ListCached returns all cache entries, the most recent first.
+func ListCached() ([]CacheEntry, error) {
+	dir, err := CacheDirectory()
+	if err != nil {
+		return nil, err
+	}
+	files, err := ioutil.ReadDir(path.Join(dir, cacheEntriesDirectory))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []CacheEntry
+	for _, file := range files {
+		if strings.HasPrefix(file.Name(), cacheTempPrefix) {
+			continue
+		}
+		entry, err := readCacheEntry(path.Join(dir, cacheEntriesDirectory, file.Name()))
+		if err == errInvalidCacheEntry {
+			// Evicted by the next GetCached of its key
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", file.Name(), err)
+		}
+		entries = append(entries, *entry)
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].CreatedAt.After(entries[j].CreatedAt)
+	})
+	return entries, nil
+}
+
+// CleanCached removes the entries older than the given age, zero removes all, and the blobs no entry refers to.
+func CleanCached(olderThan time.Duration) (int, error) {
+	dir, err := CacheDirectory()
+	if err != nil {
+		return 0, err
+	}
+	entries, err := ListCached()
+	if err != nil {
+		return 0, err
+	}
+
+	removed := 0
+	referencedBlobs := make(map[string]bool)
+	for _, entry := range entries {
+		if olderThan > 0 && time.Since(entry.CreatedAt) <= olderThan {
+			referencedBlobs[entry.Checksum] = true
+			continue
+		}
+		if err = os.Remove(path.Join(dir, cacheEntriesDirectory, checksum([]byte(entry.Key))+".json")); err != nil {
+			return removed, err
+		}
+		removed++
+	}
+
+	blobs, err := ioutil.ReadDir(path.Join(dir, cacheBlobsDirectory))
+	if os.IsNotExist(err) {
+		return removed, nil
+	}
+	for _, blob := range blobs {
+		if !referencedBlobs[blob.Name()] && !strings.HasPrefix(blob.Name(), cacheTempPrefix) {
+			if err = os.Remove(path.Join(dir, cacheBlobsDirectory, blob.Name())); err != nil {
+				return removed, err
+			}
+		}
+	}
+	return removed, err
+}
+
+func readCacheEntry(entryPath string) (*CacheEntry, error) {
+	data, err := ioutil.ReadFile(entryPath)
+	if err != nil {
+		return nil, err
+	}
+	entry := &CacheEntry{}
+	if err = json.Unmarshal(data, entry); err != nil {
+		return nil, err
+	}
+	if _, err = hex.DecodeString(entry.Checksum); err != nil || len(entry.Checksum) != 2*sha256.Size {
+		return nil, errInvalidCacheEntry
+	}
+	return entry, nil
+}
+
+func checksum(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
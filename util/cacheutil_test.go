@@ -0,0 +1,116 @@
+package util_test
+
+import (
+	"github.com/rocketlaunchercloud/rlctl/util"
+	"io/ioutil"
+	"os"
+	"path"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestCache(t *testing.T) {
+	dir, err := ioutil.TempDir("", "rlctl-cache")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	os.Setenv(util.CacheDirectoryEnv, dir)
+	defer os.Unsetenv(util.CacheDirectoryEnv)
+
+	key := "https://start.spring.io/starter.zip?name=test"
+	if err = util.PutCached(key, []byte("archive")); err != nil {
+		t.Fatal(err)
+	}
+	data, cached, err := util.GetCached(key, util.DefaultCacheTTL)
+	if err != nil || !cached || string(data) != "archive" {
+		t.Fatal("cache miss", cached, err)
+	}
+
+	// A corrupted blob must not be served
+	entries, err := util.ListCached()
+	if err != nil || len(entries) != 1 {
+		t.Fatal("unexpected entries", entries, err)
+	}
+	err = ioutil.WriteFile(path.Join(dir, "blobs", entries[0].Checksum), []byte("corrupted"), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, cached, _ = util.GetCached(key, util.DefaultCacheTTL); cached {
+		t.Fatal("corrupted entry served from the cache")
+	}
+
+	// Entries without a valid checksum are neither listed nor served
+	if err = util.PutCached(key, []byte("archive")); err != nil {
+		t.Fatal(err)
+	}
+	entryFiles, err := ioutil.ReadDir(path.Join(dir, "entries"))
+	if err != nil || len(entryFiles) != 1 {
+		t.Fatal("unexpected entry files", entryFiles, err)
+	}
+	err = ioutil.WriteFile(path.Join(dir, "entries", entryFiles[0].Name()), []byte(`{"key": "`+key+`", "sha256": "abc"}`), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if entries, err = util.ListCached(); err != nil || len(entries) != 0 {
+		t.Fatal("invalid entry listed", entries, err)
+	}
+	if _, cached, err = util.GetCached(key, 0); cached || err != nil {
+		t.Fatal("invalid entry served from the cache", err)
+	}
+
+	if err = util.PutCached(key, []byte("archive")); err != nil {
+		t.Fatal(err)
+	}
+	removed, err := util.CleanCached(0)
+	if err != nil || removed != 1 {
+		t.Fatal("unexpected clean result", removed, err)
+	}
+	if _, cached, _ = util.GetCached(key, 0); cached {
+		t.Fatal("cleaned entry served from the cache")
+	}
+}
+
+func TestCacheConcurrentWrites(t *testing.T) {
+	dir, err := ioutil.TempDir("", "rlctl-cache")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	os.Setenv(util.CacheDirectoryEnv, dir)
+	defer os.Unsetenv(util.CacheDirectoryEnv)
+
+	key := "https://start.spring.io/starter.zip?name=test"
+	data := []byte(strings.Repeat("archive", 64*1024))
+	if err = util.PutCached(key, data); err != nil {
+		t.Fatal(err)
+	}
+
+	// Readers must never see a partially written blob, which would be evicted as corrupted.
+	var wg sync.WaitGroup
+	for worker := 0; worker < 4; worker++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 20; i++ {
+				if err := util.PutCached(key, data); err != nil {
+					t.Error(err)
+				}
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 20; i++ {
+				if cached, hit, err := util.GetCached(key, 0); err != nil || !hit || len(cached) != len(data) {
+					t.Errorf("unexpected cache read %d %v %v", len(cached), hit, err)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	if entries, err := util.ListCached(); err != nil || len(entries) != 1 {
+		t.Errorf("unexpected entries %v %v", entries, err)
+	}
+}
@@ -3,34 +3,170 @@ package util
 import (
 	"fmt"
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"strconv"
+	"strings"
+	"time"
 )
 
-func ValidateRequired(value, key string) {
+func ValidateRequired(value, key string) error {
 	if value == "" {
-		LogMessageAndExit(fmt.Sprintf("%s is mandatory!\n", key))
+		return NewValidationError("%s is mandatory!", key)
 	}
+	return nil
 }
 
-func GetValue(cmd *cobra.Command, key string) string {
+// flagError returns a validation error for flags which are not defined on the command.
+func flagError(err error, key string) error {
+	if err != nil {
+		return NewValidationError("flag %s: %v", key, err)
+	}
+	return nil
+}
+
+func GetValue(cmd *cobra.Command, key string) (string, error) {
 	s, err := cmd.Flags().GetString(key)
-	LogAndExit(err, ArgMissing)
-	return s
+	return s, flagError(err, key)
 }
 
-func GetValueBool(cmd *cobra.Command, key string) bool {
+func GetValueBool(cmd *cobra.Command, key string) (bool, error) {
 	b, err := cmd.Flags().GetBool(key)
-	LogAndExit(err, ArgMissing)
-	return b
+	return b, flagError(err, key)
 }
 
-func GetValueInt32(cmd *cobra.Command, key string) int32 {
+func GetValueInt32(cmd *cobra.Command, key string) (int32, error) {
 	value, err := cmd.Flags().GetInt32(key)
-	LogAndExit(err, ArgMissing)
-	return value
+	return value, flagError(err, key)
+}
+
+func GetValueDuration(cmd *cobra.Command, key string) (time.Duration, error) {
+	value, err := cmd.Flags().GetDuration(key)
+	return value, flagError(err, key)
 }
 
-func GetValues(cmd *cobra.Command, key string) []string {
+func GetValues(cmd *cobra.Command, key string) ([]string, error) {
 	b, err := cmd.Flags().GetStringArray(key)
-	LogAndExit(err, ArgMissing)
-	return b
+	return b, flagError(err, key)
+}
+
+// FlagReader reads several flags of a command. Flags which are not defined on the command read as zero values, Err
+// returns the error of the first one.
+type FlagReader struct {
+	cmd *cobra.Command
+	err error
+}
+
+func NewFlagReader(cmd *cobra.Command) *FlagReader {
+	return &FlagReader{cmd: cmd}
+}
+
+func (reader *FlagReader) Value(key string) string {
+	value, err := GetValue(reader.cmd, key)
+	reader.keep(err)
+	return value
+}
+
+func (reader *FlagReader) Values(key string) []string {
+	values, err := GetValues(reader.cmd, key)
+	reader.keep(err)
+	return values
+}
+
+func (reader *FlagReader) Err() error {
+	return reader.err
+}
+
+func (reader *FlagReader) keep(err error) {
+	if reader.err == nil {
+		reader.err = err
+	}
+}
+
+// SetFlagValue sets the flag from its string representation. Slice flags are replaced by the comma separated values.
+func SetFlagValue(cmd *cobra.Command, key, value string) error {
+	flag := cmd.Flags().Lookup(key)
+	if flag == nil {
+		return NewValidationError("unknown flag %s", key)
+	}
+	if slice, ok := flag.Value.(pflag.SliceValue); ok {
+		values := []string{}
+		if value != "" {
+			values = strings.Split(value, ",")
+		}
+		for i := range values {
+			values[i] = strings.TrimSpace(values[i])
+		}
+		if err := slice.Replace(values); err != nil {
+			return NewValidationError("%s: %v", key, err)
+		}
+		flag.Changed = true
+		return nil
+	}
+	if err := cmd.Flags().Set(key, value); err != nil {
+		return NewValidationError("%s: %v", key, err)
+	}
+	return nil
+}
+
+// FlagValue returns the string representation of the flag, slices are comma separated.
+func FlagValue(cmd *cobra.Command, key string) string {
+	flag := cmd.Flags().Lookup(key)
+	if flag == nil {
+		return ""
+	}
+	if slice, ok := flag.Value.(pflag.SliceValue); ok {
+		return strings.Join(slice.GetSlice(), ",")
+	}
+	return flag.Value.String()
+}
+
+// SetFlagDefaults sets the flags which were not set on the command line from values keyed by flag name.
+func SetFlagDefaults(cmd *cobra.Command, values map[string]interface{}) error {
+	for key, value := range values {
+		flag := cmd.Flags().Lookup(key)
+		if flag == nil {
+			return NewValidationError("unknown setting %s", key)
+		}
+		if flag.Changed {
+			continue
+		}
+		if err := SetFlagValue(cmd, key, FlagString(value)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ValidateFlagValue checks that the string representation can be set on the flag without setting it.
+func ValidateFlagValue(flag *pflag.Flag, value string) error {
+	var err error
+	switch flag.Value.Type() {
+	case "bool":
+		_, err = strconv.ParseBool(value)
+	case "int32":
+		_, err = strconv.ParseInt(value, 10, 32)
+	case "duration":
+		_, err = time.ParseDuration(value)
+	}
+	if err != nil {
+		return NewValidationError("%s: invalid %s %q", flag.Name, flag.Value.Type(), value)
+	}
+	return nil
+}
+
+// FlagString returns the string representation of a YAML value as flags accept it, lists are comma separated.
+func FlagString(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case []interface{}:
+		items := make([]string, len(v))
+		for i, item := range v {
+			items[i] = fmt.Sprintf("%v", item)
+		}
+		return strings.Join(items, ",")
+	case []string:
+		return strings.Join(v, ",")
+	}
+	return fmt.Sprintf("%v", value)
 }
@@ -0,0 +1,24 @@
+package util_test
+
+import (
+	"github.com/rocketlaunchercloud/rlctl/util"
+	"github.com/spf13/cobra"
+	"testing"
+)
+
+func TestFlagReader(t *testing.T) {
+	cmd := &cobra.Command{}
+	cmd.Flags().String("name", "orders", "")
+	cmd.Flags().StringArray("tags", []string{"docker"}, "")
+
+	flags := util.NewFlagReader(cmd)
+	if name, tags := flags.Value("name"), flags.Values("tags"); name != "orders" || len(tags) != 1 || flags.Err() != nil {
+		t.Errorf("unexpected values %s %v %v", name, tags, flags.Err())
+	}
+	if value := flags.Value("group"); value != "" || util.KindOf(flags.Err()) != util.ValidationError {
+		t.Errorf("expected a validation error for an unknown flag, got %q %v", value, flags.Err())
+	}
+	if _, err := util.GetValueBool(cmd, "name"); util.KindOf(err) != util.ValidationError {
+		t.Errorf("expected a validation error for a flag of another type, got %v", err)
+	}
+}
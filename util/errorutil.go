@@ -1,38 +1,97 @@
 package util
 
 import (
-	"log"
+	"errors"
+	"fmt"
 	"os"
 )
 
+// ErrorKind classifies the errors returned by rlctl packages, only cmd maps the kinds to exit codes.
+type ErrorKind int
+
 const (
-	EnvironmentError = 1
-	FileNotFound     = 2
-	InvalidTemplate  = 3
-	ArgMissing       = 4
-	NetworkError     = 5
+	UnknownError ErrorKind = iota
+	FileSystemError
+	TemplateError
+	ValidationError
+	NetworkError
 )
 
-type ChannelResponse struct {
-	Success bool
-	Error   error
-	Data    []byte
+var errorKindNames = map[ErrorKind]string{
+	UnknownError:    "unknown",
+	FileSystemError: "filesystem",
+	TemplateError:   "template",
+	ValidationError: "validation",
+	NetworkError:    "network",
+}
+
+func (kind ErrorKind) String() string {
+	return errorKindNames[kind]
+}
+
+// Error is a classified error. Subject names what failed, e.g. the template, path or url.
+type Error struct {
+	Kind    ErrorKind
+	Subject string
+	Err     error
 }
 
-func Error(err error) bool {
-	if err != nil {
-		log.Fatal(err)
-		return true
+func (e *Error) Error() string {
+	if e.Subject == "" {
+		return e.Err.Error()
 	}
-	return false
+	return fmt.Sprintf("%s: %v", e.Subject, e.Err)
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+type ChannelResponse struct {
+	Success    bool
+	Error      error
+	Data       []byte
+	StatusCode int
 }
 
-func LogAndExit(err error, exitCode int) {
-	if Error(err) {
-		os.Exit(exitCode)
+func newError(kind ErrorKind, subject string, err error) error {
+	if err == nil {
+		return nil
 	}
+	return &Error{Kind: kind, Subject: subject, Err: err}
 }
 
-func LogMessageAndExit(message string) {
-	log.Fatal(message)
+// NewFileSystemError classifies err as a filesystem error of path, it returns nil if err is nil.
+func NewFileSystemError(path string, err error) error {
+	return newError(FileSystemError, path, err)
+}
+
+// NewTemplateError classifies err as an error reading or rendering the template, it returns nil if err is nil.
+func NewTemplateError(template string, err error) error {
+	return newError(TemplateError, template, err)
+}
+
+// NewNetworkError classifies err as an error requesting url, it returns nil if err is nil.
+func NewNetworkError(url string, err error) error {
+	return newError(NetworkError, url, err)
+}
+
+// NewValidationError reports invalid input.
+func NewValidationError(format string, args ...interface{}) error {
+	return &Error{Kind: ValidationError, Err: fmt.Errorf(format, args...)}
+}
+
+// KindOf returns the kind of the first classified error in the chain of err. Unclassified os errors are filesystem
+// errors.
+func KindOf(err error) ErrorKind {
+	var classified *Error
+	if errors.As(err, &classified) {
+		return classified.Kind
+	}
+	var pathError *os.PathError
+	var linkError *os.LinkError
+	if errors.As(err, &pathError) || errors.As(err, &linkError) {
+		return FileSystemError
+	}
+	return UnknownError
 }
@@ -0,0 +1,33 @@
+package util_test
+
+import (
+	"fmt"
+	"github.com/rocketlaunchercloud/rlctl/util"
+	"os"
+	"testing"
+)
+
+func TestKindOf(t *testing.T) {
+	_, statErr := os.Stat("/does/not/exist")
+	_, templateErr := util.ParseTemplate(nil, "broken", "{{.Missing")
+
+	cases := []struct {
+		err  error
+		kind util.ErrorKind
+	}{
+		{fmt.Errorf("plain"), util.UnknownError},
+		{statErr, util.FileSystemError},
+		{fmt.Errorf("unable to save: %w", statErr), util.FileSystemError},
+		{templateErr, util.TemplateError},
+		{util.NewValidationError("%s is mandatory!", "name"), util.ValidationError},
+		{fmt.Errorf("wrapped: %w", util.NewNetworkError("https://start.spring.io", fmt.Errorf("timeout"))), util.NetworkError},
+	}
+	for _, c := range cases {
+		if kind := util.KindOf(c.err); kind != c.kind {
+			t.Errorf("%v: expected %s, got %s", c.err, c.kind, kind)
+		}
+	}
+	if util.NewFileSystemError("path", nil) != nil {
+		t.Error("expected nil for nil error")
+	}
+}
@@ -1,79 +1,248 @@
 package util
 
 import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
 	"log"
 	"os"
 	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
 )
 
-func GitInitNewRepo(repoPath string) {
-	cwd, err := os.Getwd()
-	LogAndExit(err, EnvironmentError)
+const (
+	DefaultGitBranch = "main"
+	GitTokenEnv      = "RLCTL_GIT_TOKEN"
+)
 
-	os.Chdir(repoPath)
-	cmd := exec.Command("git", "init")
-	stdout, err := cmd.Output()
+var gitVersionPattern = regexp.MustCompile(`git version (\d+)\.(\d+)`)
+
+// GitRepository runs git in the working tree at Path. Every field except Path is optional, unset fields leave the
+// corresponding git config in charge.
+type GitRepository struct {
+	Path string
+	// Branch is the initial branch of new repositories, it defaults to DefaultGitBranch.
+	Branch string
+	// Author is the author and committer of commits, e.g. "Jane Doe <jane@example.com>".
+	Author string
+	// Sign signs commits with SigningKey or the default key of the user.
+	Sign       bool
+	SigningKey string
+	// Token authenticates pushes to HTTPS remotes as TokenUser, it defaults to $RLCTL_GIT_TOKEN.
+	Token     string
+	TokenUser string
+	// SSHKeyFile is the private key used for SSH remotes.
+	SSHKeyFile string
+}
 
-	if err != nil {
-		log.Println(err.Error())
-		return
+// Init creates the repository, existing repositories keep their branch.
+func (repo GitRepository) Init(ctx context.Context) error {
+	if exists, _ := Exists(filepath.Join(repo.Path, ".git")); exists {
+		return nil
 	}
-
-	log.Print(string(stdout))
-
-	os.Chdir(cwd)
+	if err := repo.git(ctx, nil, "init"); err != nil {
+		return err
+	}
+	branch := repo.Branch
+	if branch == "" {
+		branch = DefaultGitBranch
+	}
+	// symbolic-ref instead of init --initial-branch, which requires git 2.28.
+	return repo.git(ctx, nil, "symbolic-ref", "HEAD", "refs/heads/"+branch)
 }
 
-func GitAddAll(repoPath string) {
-	cwd, err := os.Getwd()
-	LogAndExit(err, EnvironmentError)
-
-	os.Chdir(repoPath)
-	cmd := exec.Command("git", "add", ".")
-	stdout, err := cmd.Output()
+func (repo GitRepository) AddAll(ctx context.Context) error {
+	return repo.git(ctx, nil, "add", "--all", ".")
+}
 
+// SetRemote adds the remote. An existing remote must have the url, repositories merged into keep their remotes.
+func (repo GitRepository) SetRemote(ctx context.Context, name, url string) error {
+	existing, err := repo.output(ctx, nil, "remote", "get-url", name)
 	if err != nil {
-		log.Println(err.Error())
-		return
+		return repo.git(ctx, nil, "remote", "add", name, url)
+	}
+	if existing != url {
+		return NewValidationError("the remote %s of %s points to %s instead of %s, remove or rename it first",
+			name, repo.Path, existing, url)
 	}
+	return nil
+}
 
-	log.Print(string(stdout))
+func (repo GitRepository) Commit(ctx context.Context, message string) error {
+	args := []string{"commit", "-m", message}
+	if repo.Sign {
+		args = append(args, "--gpg-sign="+repo.SigningKey)
+	}
 
-	os.Chdir(cwd)
+	var env []string
+	if repo.Author != "" {
+		name, email, err := ParseGitAuthor(repo.Author)
+		if err != nil {
+			return err
+		}
+		env = []string{
+			"GIT_AUTHOR_NAME=" + name, "GIT_AUTHOR_EMAIL=" + email,
+			"GIT_COMMITTER_NAME=" + name, "GIT_COMMITTER_EMAIL=" + email,
+		}
+	}
+	return repo.git(ctx, env, args...)
 }
 
-func GitAddRemote(repoPath string, repositoryUrl string) {
-	cwd, err := os.Getwd()
-	LogAndExit(err, EnvironmentError)
+// Push pushes the current branch to the remote and sets it as upstream.
+func (repo GitRepository) Push(ctx context.Context, remote string) error {
+	env, err := repo.remoteEnv(ctx, remote)
+	if err != nil {
+		return err
+	}
+	return repo.git(ctx, env, "push", "-u", remote, "HEAD")
+}
 
-	os.Chdir(repoPath)
-	cmd := exec.Command("git", "remote", "add", "origin", repositoryUrl)
-	stdout, err := cmd.Output()
+// StartBranch fetches the base branch of the remote and starts the branch on top of it, the working tree is kept. Files of
+// the base branch missing in the working tree are restored, committing adds to the base branch and deletes nothing.
+func (repo GitRepository) StartBranch(ctx context.Context, remote, base, branch string) error {
+	env, err := repo.remoteEnv(ctx, remote)
+	if err != nil {
+		return err
+	}
+	if err = repo.git(ctx, env, "fetch", remote, base); err != nil {
+		return err
+	}
+	if err = repo.git(ctx, nil, "reset", "--quiet", "FETCH_HEAD"); err != nil {
+		return err
+	}
+	deleted, err := repo.output(ctx, nil, "ls-files", "--deleted", "-z")
+	if err != nil {
+		return err
+	}
+	if deleted = strings.Trim(deleted, "\x00"); deleted != "" {
+		args := append([]string{"checkout", "--"}, strings.Split(deleted, "\x00")...)
+		if err = repo.git(ctx, nil, args...); err != nil {
+			return err
+		}
+	}
+	return repo.git(ctx, nil, "checkout", "-B", branch)
+}
 
+// remoteEnv authenticates HTTPS remotes with the token and SSH remotes with the key file if they are set.
+func (repo GitRepository) remoteEnv(ctx context.Context, remote string) ([]string, error) {
+	url, err := repo.output(ctx, nil, "remote", "get-url", remote)
 	if err != nil {
-		log.Println(err.Error())
-		return
+		return nil, err
 	}
 
-	log.Print(string(stdout))
+	var env []string
+	switch {
+	case strings.HasPrefix(url, "https://") || strings.HasPrefix(url, "http://"):
+		token := repo.Token
+		if token == "" {
+			token = os.Getenv(GitTokenEnv)
+		}
+		if token != "" {
+			user := repo.TokenUser
+			if user == "" {
+				user = "oauth2"
+			}
+			// The token is passed through the environment, it is neither stored in .git/config nor visible in the
+			// process list. Older git ignores GIT_CONFIG_COUNT and would push without the token.
+			if err = repo.requireGitVersion(ctx, 2, 31, "pushing with a token"); err != nil {
+				return nil, err
+			}
+			credentials := base64.StdEncoding.EncodeToString([]byte(user + ":" + token))
+			env = append(env, "GIT_CONFIG_COUNT=1", "GIT_CONFIG_KEY_0=http.extraHeader",
+				"GIT_CONFIG_VALUE_0=Authorization: Basic "+credentials)
+		}
+	case repo.SSHKeyFile != "":
+		env = append(env, fmt.Sprintf("GIT_SSH_COMMAND=ssh -i %q -o IdentitiesOnly=yes", repo.SSHKeyFile))
+	}
+	return env, nil
+}
 
-	os.Chdir(cwd)
+// Publish initialises the repository, commits all files and pushes them to the url as origin. Nothing is committed if
+// the files were committed by a publication which failed to push.
+func (repo GitRepository) Publish(ctx context.Context, url, message string) error {
+	steps := []func() error{
+		func() error { return repo.Init(ctx) },
+		func() error { return repo.AddAll(ctx) },
+		func() error { return repo.SetRemote(ctx, "origin", url) },
+		func() error {
+			if _, err := repo.output(ctx, nil, "rev-parse", "--verify", "--quiet", "HEAD"); err == nil {
+				if _, err = repo.output(ctx, nil, "diff", "--cached", "--quiet"); err == nil {
+					return nil
+				}
+			}
+			return repo.Commit(ctx, message)
+		},
+		func() error { return repo.Push(ctx, "origin") },
+	}
+	for _, step := range steps {
+		if err := step(); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
-func GitCommit(repoPath string, message string) {
-	cwd, err := os.Getwd()
-	LogAndExit(err, EnvironmentError)
+// ParseGitAuthor splits "Name <email>" into name and email.
+func ParseGitAuthor(author string) (string, string, error) {
+	start, end := strings.Index(author, "<"), strings.LastIndex(author, ">")
+	if start < 1 || end < start {
+		return "", "", NewValidationError("invalid git author %q, expected \"Name <email>\"", author)
+	}
+	return strings.TrimSpace(author[:start]), strings.TrimSpace(author[start+1 : end]), nil
+}
 
-	os.Chdir(repoPath)
-	cmd := exec.Command("git", "commit", "-m", message)
-	stdout, err := cmd.Output()
+// ParseGitVersion returns the major and minor version of the output of git --version, e.g. "git version 2.31.1".
+func ParseGitVersion(output string) (int, int, error) {
+	match := gitVersionPattern.FindStringSubmatch(output)
+	if match == nil {
+		return 0, 0, NewValidationError("unknown git version %q", output)
+	}
+	major, _ := strconv.Atoi(match[1])
+	minor, _ := strconv.Atoi(match[2])
+	return major, minor, nil
+}
 
+// requireGitVersion fails with a validation error naming the feature if git is older than major.minor.
+func (repo GitRepository) requireGitVersion(ctx context.Context, major, minor int, feature string) error {
+	output, err := repo.output(ctx, nil, "--version")
 	if err != nil {
-		log.Println(err.Error())
-		return
+		return err
+	}
+	installedMajor, installedMinor, err := ParseGitVersion(output)
+	if err != nil {
+		return err
+	}
+	if installedMajor < major || installedMajor == major && installedMinor < minor {
+		return NewValidationError("%s requires git %d.%d or newer, found %s", feature, major, minor, output)
 	}
+	return nil
+}
 
-	log.Print(string(stdout))
+func (repo GitRepository) git(ctx context.Context, env []string, args ...string) error {
+	output, err := repo.output(ctx, env, args...)
+	if err == nil && output != "" {
+		log.Println(output)
+	}
+	return err
+}
 
-	os.Chdir(cwd)
+// output runs git and returns its trimmed output, errors carry the output of git.
+func (repo GitRepository) output(ctx context.Context, env []string, args ...string) (string, error) {
+	// cmd.Dir instead of os.Chdir, the working directory is shared by all goroutines.
+	cmd := exec.CommandContext(ctx, "git", args...)
+	cmd.Dir = repo.Path
+	// Git must fail instead of waiting for credentials on the terminal.
+	cmd.Env = append(append(os.Environ(), "GIT_TERMINAL_PROMPT=0"), env...)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		message := strings.TrimSpace(stderr.String() + "\n" + stdout.String())
+		return "", fmt.Errorf("git %s failed: %w: %s", args[0], err, message)
+	}
+	return strings.TrimSpace(stdout.String()), nil
 }
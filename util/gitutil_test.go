@@ -0,0 +1,81 @@
+package util_test
+
+import (
+	"context"
+	"github.com/rocketlaunchercloud/rlctl/util"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path"
+	"strings"
+	"testing"
+)
+
+func TestGitPublish(t *testing.T) {
+	root, err := ioutil.TempDir("", "rlctl-git")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+
+	remote := path.Join(root, "remote.git")
+	if output, err := exec.Command("git", "init", "--bare", remote).CombinedOutput(); err != nil {
+		t.Fatal(string(output), err)
+	}
+	project := path.Join(root, "project")
+	os.MkdirAll(project, 0700)
+	if err = ioutil.WriteFile(path.Join(project, "README.md"), []byte("demo"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	repository := util.GitRepository{Path: project, Branch: "trunk", Author: "Jane Doe <jane@example.com>"}
+	if err = repository.Publish(ctx, remote, "Initial Commit!"); err != nil {
+		t.Fatal(err)
+	}
+	output, err := exec.Command("git", "--git-dir", remote, "log", "--format=%an <%ae> %s", "trunk").CombinedOutput()
+	if err != nil {
+		t.Fatal(string(output), err)
+	}
+	if strings.TrimSpace(string(output)) != "Jane Doe <jane@example.com> Initial Commit!" {
+		t.Errorf("unexpected log %q", output)
+	}
+
+	// Failures are returned together with the output of git
+	err = repository.Commit(ctx, "Nothing to commit")
+	if err == nil || !strings.Contains(err.Error(), "nothing to commit") {
+		t.Error("expected the commit to fail, got", err)
+	}
+	// Existing remotes are kept
+	if err = repository.SetRemote(ctx, "origin", remote); err != nil {
+		t.Fatal(err)
+	}
+	if err = repository.SetRemote(ctx, "origin", path.Join(root, "missing.git")); util.KindOf(err) != util.ValidationError {
+		t.Error("expected a validation error, got", err)
+	}
+	if err = repository.SetRemote(ctx, "missing", path.Join(root, "missing.git")); err != nil {
+		t.Fatal(err)
+	}
+	if err = repository.Push(ctx, "missing"); err == nil {
+		t.Error("expected the push to a missing remote to fail")
+	}
+	if _, _, err = util.ParseGitAuthor("Jane Doe"); util.KindOf(err) != util.ValidationError {
+		t.Error("expected a validation error, got", err)
+	}
+}
+
+func TestParseGitVersion(t *testing.T) {
+	versions := map[string][2]int{
+		"git version 2.31.1":                 {2, 31},
+		"git version 2.24.3 (Apple Git-128)": {2, 24},
+		"git version 2.39.5.windows.1":       {2, 39},
+	}
+	for output, expected := range versions {
+		if major, minor, err := util.ParseGitVersion(output); err != nil || major != expected[0] || minor != expected[1] {
+			t.Errorf("%s: unexpected version %d.%d %v", output, major, minor, err)
+		}
+	}
+	if _, _, err := util.ParseGitVersion("unknown"); util.KindOf(err) != util.ValidationError {
+		t.Error("expected a validation error, got", err)
+	}
+}
@@ -1,20 +1,51 @@
 package util
 
 import (
+	"errors"
+	"fmt"
 	"io/ioutil"
 	"net/http"
+	"net/url"
+	"strings"
 )
 
+// HttpStatusError is the error of a response with a 4xx or 5xx status.
+type HttpStatusError struct {
+	StatusCode int
+	Status     string
+	Body       string
+}
+
+func (e *HttpStatusError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Status, e.Body)
+}
+
+// HttpStatus returns the status code of the HttpStatusError in the chain of err, 0 if there is none.
+func HttpStatus(err error) int {
+	var statusError *HttpStatusError
+	if errors.As(err, &statusError) {
+		return statusError.StatusCode
+	}
+	return 0
+}
+
 func MakeHttpRequest(req *http.Request, ch chan<- ChannelResponse) {
 	client := &http.Client{}
+	// The query is left out of errors, it may hold credentials.
+	address := req.URL.Scheme + "://" + req.URL.Host + req.URL.Path
 	response, err := client.Do(req)
 	if err != nil {
-		ch <- ChannelResponse{Error: err, Success: false}
+		// The url.Error of the client holds the full URL, only its cause is kept
+		var urlError *url.Error
+		if errors.As(err, &urlError) {
+			err = urlError.Err
+		}
+		ch <- ChannelResponse{Error: NewNetworkError(address, err), Success: false}
 		return
 	}
 
 	if response == nil || response.Body == nil {
-		ch <- ChannelResponse{Error: err, Success: false}
+		ch <- ChannelResponse{Error: NewNetworkError(address, errors.New("empty response")), Success: false}
 		return
 	}
 
@@ -22,8 +53,13 @@ func MakeHttpRequest(req *http.Request, ch chan<- ChannelResponse) {
 
 	responseData, err := ioutil.ReadAll(response.Body)
 	if err != nil {
-		ch <- ChannelResponse{Error: err, Success: false}
+		ch <- ChannelResponse{Error: NewNetworkError(address, err), Success: false}
+		return
+	}
+	if response.StatusCode >= http.StatusBadRequest {
+		err = &HttpStatusError{StatusCode: response.StatusCode, Status: response.Status, Body: strings.TrimSpace(string(responseData))}
+		ch <- ChannelResponse{Error: NewNetworkError(address, err), Success: false, StatusCode: response.StatusCode}
 		return
 	}
-	ch <- ChannelResponse{Error: nil, Success: true, Data: responseData}
+	ch <- ChannelResponse{Error: nil, Success: true, Data: responseData, StatusCode: response.StatusCode}
 }
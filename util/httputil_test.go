@@ -0,0 +1,28 @@
+package util_test
+
+import (
+	"github.com/rocketlaunchercloud/rlctl/util"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestMakeHttpRequestError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	server.Close()
+
+	req, err := http.NewRequest(http.MethodGet, server.URL+"/projects?private_token=secret", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ch := make(chan util.ChannelResponse, 1)
+	util.MakeHttpRequest(req, ch)
+	response := <-ch
+	if response.Success || util.KindOf(response.Error) != util.NetworkError {
+		t.Fatalf("expected a network error, got %v", response.Error)
+	}
+	if message := response.Error.Error(); strings.Contains(message, "secret") || !strings.Contains(message, server.URL+"/projects") {
+		t.Errorf("expected the url without its query, got %s", message)
+	}
+}
@@ -11,5 +11,12 @@ var (
 )
 
 func GetSpringTemplate(templateName string) (string, error) {
-	return springTemplatesBox.FindString(templateName)
+	template, err := springTemplatesBox.FindString(templateName)
+	return template, NewTemplateError(templateName, err)
+}
+
+// GetSpringFile returns the bytes of a bundled binary file, e.g. a wrapper jar.
+func GetSpringFile(fileName string) ([]byte, error) {
+	data, err := springTemplatesBox.Find(fileName)
+	return data, NewTemplateError(fileName, err)
 }
@@ -2,28 +2,36 @@ package util
 
 import (
 	"github.com/google/uuid"
+	"io/ioutil"
 	"os"
 	"path"
+	"strings"
 )
 
-var (
-	TempDirectory   string
-	OutputDirectory string
+const (
+	DefaultOutputDirectory = "build"
 )
 
-func init() {
-	dir, err := os.Getwd()
-	LogAndExit(err, EnvironmentError)
-	TempDirectory = path.Join(dir, "tmp")
-	CreateDirIfNotExists(&TempDirectory)
-	OutputDirectory = path.Join(dir, "build")
-	CreateDirIfNotExists(&OutputDirectory)
+// repositoryFiles lists the entries a freshly cloned or initialized repository may contain, e.g. the files GitLab
+// creates with a new project. Such a directory is used as generation target like an empty one, generated files
+// replace them.
+var repositoryFiles = map[string]bool{
+	".git":           true,
+	".gitignore":     true,
+	".gitattributes": true,
+	"readme":         true,
+	"readme.md":      true,
+	"readme.adoc":    true,
+	"license":        true,
+	"license.md":     true,
+	"license.txt":    true,
 }
 
-func CreateDirIfNotExists(dir *string) {
+func CreateDirIfNotExists(dir *string) error {
 	if result, _ := Exists(*dir); !result {
-		LogAndExit(os.Mkdir(*dir, os.ModePerm), EnvironmentError)
+		return os.MkdirAll(*dir, os.ModePerm)
 	}
+	return nil
 }
 
 func GenerateTemporaryFileName() (string, error) {
@@ -31,5 +39,23 @@ func GenerateTemporaryFileName() (string, error) {
 	if err != nil {
 		return "", err
 	}
-	return path.Join(TempDirectory, uuid.String()+".zip"), nil
+	return path.Join(os.TempDir(), "rlctl-"+uuid.String()+".zip"), nil
+}
+
+// ValidateOutputDirectory checks that the directory does not exist yet, is empty or only holds repository metadata, a
+// README or a LICENSE.
+func ValidateOutputDirectory(dir string) error {
+	files, err := ioutil.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	for _, file := range files {
+		if !repositoryFiles[strings.ToLower(file.Name())] {
+			return NewValidationError("%s is not empty, it holds %s: --merge generates into it, --force replaces its content", dir, file.Name())
+		}
+	}
+	return nil
 }
@@ -0,0 +1,46 @@
+package util_test
+
+import (
+	"github.com/rocketlaunchercloud/rlctl/util"
+	"io/ioutil"
+	"os"
+	"path"
+	"strings"
+	"testing"
+)
+
+func TestValidateOutputDirectory(t *testing.T) {
+	root, err := ioutil.TempDir("", "rlctl")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+
+	if err = util.ValidateOutputDirectory(path.Join(root, "missing")); err != nil {
+		t.Errorf("a missing directory must be valid: %v", err)
+	}
+	if err = util.ValidateOutputDirectory(root); err != nil {
+		t.Errorf("an empty directory must be valid: %v", err)
+	}
+
+	// A new GitLab project cloned with its README, LICENSE and .gitignore
+	if err = os.Mkdir(path.Join(root, ".git"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	for _, file := range []string{"README.md", "LICENSE", ".gitignore"} {
+		if err = ioutil.WriteFile(path.Join(root, file), []byte(file), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err = util.ValidateOutputDirectory(root); err != nil {
+		t.Errorf("a new repository must be valid: %v", err)
+	}
+
+	if err = ioutil.WriteFile(path.Join(root, "build.gradle"), nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+	err = util.ValidateOutputDirectory(root)
+	if util.KindOf(err) != util.ValidationError || !strings.Contains(err.Error(), "build.gradle") || !strings.Contains(err.Error(), "--merge") {
+		t.Errorf("expected a validation error naming build.gradle and --merge, got %v", err)
+	}
+}
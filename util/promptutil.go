@@ -0,0 +1,110 @@
+package util
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// Prompter asks questions on a terminal and reads the answers line by line.
+type Prompter struct {
+	in  *bufio.Reader
+	out io.Writer
+}
+
+func NewPrompter(in io.Reader, out io.Writer) *Prompter {
+	return &Prompter{in: bufio.NewReader(in), out: out}
+}
+
+// Ask returns the answer to the question, or defaultValue if the answer is empty. The question is repeated until
+// validate, which may be nil, accepts the answer.
+func (prompter *Prompter) Ask(question, defaultValue string, validate func(string) error) (string, error) {
+	for {
+		if defaultValue != "" {
+			fmt.Fprintf(prompter.out, "%s [%s]: ", question, defaultValue)
+		} else {
+			fmt.Fprintf(prompter.out, "%s: ", question)
+		}
+
+		line, err := prompter.in.ReadString('\n')
+		answer := strings.TrimSpace(line)
+		if err == io.EOF && answer == "" {
+			return "", NewValidationError("no answer to %q", question)
+		}
+		if err != nil && err != io.EOF {
+			return "", err
+		}
+		if answer == "" {
+			answer = defaultValue
+		}
+
+		if validate == nil {
+			return answer, nil
+		}
+		if err = validate(answer); err == nil {
+			return answer, nil
+		}
+		fmt.Fprintf(prompter.out, "  %v\n", err)
+	}
+}
+
+// Confirm asks a yes/no question.
+func (prompter *Prompter) Confirm(question string, defaultValue bool) (bool, error) {
+	defaultAnswer := "n"
+	if defaultValue {
+		defaultAnswer = "y"
+	}
+	answer, err := prompter.Ask(question+" (y/n)", defaultAnswer, func(answer string) error {
+		if _, ok := parseYesNo(answer); !ok {
+			return fmt.Errorf("answer y or n")
+		}
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+	value, _ := parseYesNo(answer)
+	return value, nil
+}
+
+// Choose lets the user pick one of the options either by its number or by its value.
+func (prompter *Prompter) Choose(question string, options []string, defaultValue string) (string, error) {
+	for i, option := range options {
+		fmt.Fprintf(prompter.out, "  %d) %s\n", i+1, option)
+	}
+	answer, err := prompter.Ask(question, defaultValue, func(answer string) error {
+		if _, ok := choice(options, answer); !ok {
+			return fmt.Errorf("choose one of 1-%d", len(options))
+		}
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+	value, _ := choice(options, answer)
+	return value, nil
+}
+
+func parseYesNo(answer string) (bool, bool) {
+	switch strings.ToLower(answer) {
+	case "y", "yes", "true":
+		return true, true
+	case "n", "no", "false":
+		return false, true
+	}
+	return false, false
+}
+
+func choice(options []string, answer string) (string, bool) {
+	if index, err := strconv.Atoi(answer); err == nil && index >= 1 && index <= len(options) {
+		return options[index-1], true
+	}
+	for _, option := range options {
+		if strings.EqualFold(option, answer) {
+			return option, true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,43 @@
+package util_test
+
+import (
+	"bytes"
+	"github.com/rocketlaunchercloud/rlctl/util"
+	"strings"
+	"testing"
+)
+
+func TestPrompter(t *testing.T) {
+	in := strings.NewReader("\nabc\n42\nmaybe\nn\n2\n")
+	var out bytes.Buffer
+	prompter := util.NewPrompter(in, &out)
+
+	answer, err := prompter.Ask("Name", "demo", nil)
+	if err != nil || answer != "demo" {
+		t.Errorf("expected the default, got %q %v", answer, err)
+	}
+
+	answer, err = prompter.Ask("Port", "", func(answer string) error {
+		if answer != "42" {
+			return util.NewValidationError("not a port")
+		}
+		return nil
+	})
+	if err != nil || answer != "42" {
+		t.Errorf("expected the question to be repeated until the answer is valid, got %q %v", answer, err)
+	}
+
+	confirmed, err := prompter.Confirm("Continue", true)
+	if err != nil || confirmed {
+		t.Errorf("expected no, got %v %v", confirmed, err)
+	}
+
+	choice, err := prompter.Choose("Language", []string{"java", "kotlin"}, "java")
+	if err != nil || choice != "kotlin" {
+		t.Errorf("expected kotlin, got %q %v", choice, err)
+	}
+
+	if _, err = prompter.Ask("More", "", nil); util.KindOf(err) != util.ValidationError {
+		t.Errorf("expected a validation error at the end of the input, got %v", err)
+	}
+}
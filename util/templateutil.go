@@ -8,12 +8,12 @@ import (
 func ParseTemplate(templateData interface{}, templateFile, templateStr string) (string, error) {
 	t, err := template.New(templateFile).Parse(templateStr)
 	if err != nil {
-		return "", nil
+		return "", NewTemplateError(templateFile, err)
 	}
 	var tmpl bytes.Buffer
 	err = t.ExecuteTemplate(&tmpl, templateFile, templateData)
 	if err != nil {
-		return "", nil
+		return "", NewTemplateError(templateFile, err)
 	}
 	return tmpl.String(), nil
 }
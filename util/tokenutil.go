@@ -0,0 +1,321 @@
+package util
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/mitchellh/go-homedir"
+	"github.com/spf13/viper"
+	"gopkg.in/yaml.v2"
+	"io/ioutil"
+	"log"
+	"os"
+	"os/exec"
+	"path"
+	"runtime"
+	"strings"
+)
+
+const (
+	GitlabTokenEnv     = "RLCTL_GITLAB_TOKEN"
+	GitlabTokenCommand = "gitlab-token-command"
+	KeyringFileEnv     = "RLCTL_KEYRING_FILE"
+
+	keyringService = "rlctl"
+)
+
+// TokenProvider looks a token up, an empty token without error means the provider has none.
+type TokenProvider interface {
+	Name() string
+	Token() (string, error)
+}
+
+// EnvTokenProvider reads the token from an environment variable.
+type EnvTokenProvider struct {
+	Variable string
+}
+
+func (provider EnvTokenProvider) Name() string {
+	return "$" + provider.Variable
+}
+
+func (provider EnvTokenProvider) Token() (string, error) {
+	return strings.TrimSpace(os.Getenv(provider.Variable)), nil
+}
+
+// CommandTokenProvider runs a shell command, e.g. `pass show gitlab/token`, and uses the first line of its output.
+type CommandTokenProvider struct {
+	Command string
+}
+
+func (provider CommandTokenProvider) Name() string {
+	return "command " + provider.Command
+}
+
+func (provider CommandTokenProvider) Token() (string, error) {
+	if provider.Command == "" {
+		return "", nil
+	}
+	cmd := shellCommand(provider.Command)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	output, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("token command %q failed: %w %s", provider.Command, err, strings.TrimSpace(stderr.String()))
+	}
+	return strings.TrimSpace(strings.SplitN(string(output), "\n", 2)[0]), nil
+}
+
+// KeyringTokenProvider reads the token stored under Key in a keyring.
+type KeyringTokenProvider struct {
+	Keyring Keyring
+	Key     string
+}
+
+func (provider KeyringTokenProvider) Name() string {
+	return provider.Keyring.Name()
+}
+
+func (provider KeyringTokenProvider) Token() (string, error) {
+	return provider.Keyring.Get(provider.Key)
+}
+
+// configTokenProvider reads a plain text token written to the config file by former versions.
+type configTokenProvider struct{}
+
+func (configTokenProvider) Name() string {
+	return viper.ConfigFileUsed()
+}
+
+func (configTokenProvider) Token() (string, error) {
+	return viper.GetString(GitlabToken), nil
+}
+
+// Keyring stores secrets by key, Get returns an empty secret for unknown keys.
+type Keyring interface {
+	Name() string
+	Get(key string) (string, error)
+	Set(key, secret string) error
+	Delete(key string) error
+}
+
+// FileKeyring keeps the secrets in a YAML file only its owner may read. It is the fallback for systems without a
+// keyring service.
+type FileKeyring struct {
+	Path string
+}
+
+func (keyring FileKeyring) Name() string {
+	return keyring.Path
+}
+
+func (keyring FileKeyring) Get(key string) (string, error) {
+	secrets, err := keyring.read()
+	return secrets[key], err
+}
+
+func (keyring FileKeyring) Set(key, secret string) error {
+	secrets, err := keyring.read()
+	if err != nil {
+		return err
+	}
+	secrets[key] = secret
+	return keyring.write(secrets)
+}
+
+func (keyring FileKeyring) Delete(key string) error {
+	secrets, err := keyring.read()
+	if err != nil {
+		return err
+	}
+	if _, found := secrets[key]; !found {
+		return nil
+	}
+	delete(secrets, key)
+	return keyring.write(secrets)
+}
+
+func (keyring FileKeyring) read() (map[string]string, error) {
+	secrets := map[string]string{}
+	info, err := os.Stat(keyring.Path)
+	if os.IsNotExist(err) {
+		return secrets, nil
+	}
+	if err != nil {
+		return nil, NewFileSystemError(keyring.Path, err)
+	}
+	if runtime.GOOS != "windows" && info.Mode().Perm()&0077 != 0 {
+		return nil, NewValidationError("permissions %#o of %s are too open, run chmod 600 %s",
+			info.Mode().Perm(), keyring.Path, keyring.Path)
+	}
+	data, err := ioutil.ReadFile(keyring.Path)
+	if err != nil {
+		return nil, NewFileSystemError(keyring.Path, err)
+	}
+	if err = yaml.Unmarshal(data, &secrets); err != nil {
+		return nil, NewValidationError("invalid keyring %s: %v", keyring.Path, err)
+	}
+	return secrets, nil
+}
+
+func (keyring FileKeyring) write(secrets map[string]string) error {
+	data, err := yaml.Marshal(secrets)
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(path.Dir(keyring.Path), 0700); err != nil {
+		return NewFileSystemError(path.Dir(keyring.Path), err)
+	}
+	return writePrivateFile(keyring.Path, data)
+}
+
+// SystemKeyring uses the keyring service of the OS through the macOS security or the libsecret secret-tool command.
+type SystemKeyring struct {
+	command string
+}
+
+func (keyring SystemKeyring) Name() string {
+	return keyring.command
+}
+
+func (keyring SystemKeyring) Get(key string) (string, error) {
+	var cmd *exec.Cmd
+	if keyring.command == "security" {
+		cmd = exec.Command("security", "find-generic-password", "-s", keyringService, "-a", key, "-w")
+	} else {
+		cmd = exec.Command("secret-tool", "lookup", "service", keyringService, "account", key)
+	}
+	output, err := cmd.Output()
+	if _, missing := err.(*exec.ExitError); missing {
+		// Both commands fail for unknown keys.
+		return "", nil
+	}
+	return strings.TrimSpace(string(output)), err
+}
+
+func (keyring SystemKeyring) Set(key, secret string) error {
+	var cmd *exec.Cmd
+	if keyring.command == "security" {
+		// Without a value -w prompts for the password and its confirmation on stdin, the secret stays out of the
+		// process list.
+		cmd = exec.Command("security", "add-generic-password", "-U", "-s", keyringService, "-a", key, "-w")
+		cmd.Stdin = strings.NewReader(secret + "\n" + secret + "\n")
+	} else {
+		cmd = exec.Command("secret-tool", "store", "--label", keyringService+" "+key,
+			"service", keyringService, "account", key)
+		cmd.Stdin = strings.NewReader(secret)
+	}
+	return runKeyringCommand(cmd)
+}
+
+func (keyring SystemKeyring) Delete(key string) error {
+	if keyring.command == "security" {
+		if secret, err := keyring.Get(key); err != nil || secret == "" {
+			return err
+		}
+		return runKeyringCommand(exec.Command("security", "delete-generic-password", "-s", keyringService, "-a", key))
+	}
+	return runKeyringCommand(exec.Command("secret-tool", "clear", "service", keyringService, "account", key))
+}
+
+func runKeyringCommand(cmd *exec.Cmd) error {
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("%s failed: %w %s", cmd.Args[0], err, strings.TrimSpace(string(output)))
+	}
+	return nil
+}
+
+// DefaultKeyring returns the keyring service of the OS if there is one, otherwise the file keyring at
+// $RLCTL_KEYRING_FILE or ~/.config/rlctl/credentials.
+func DefaultKeyring() (Keyring, error) {
+	if file := os.Getenv(KeyringFileEnv); file != "" {
+		return FileKeyring{Path: file}, nil
+	}
+	switch {
+	case runtime.GOOS == "darwin" && commandExists("security"):
+		return SystemKeyring{command: "security"}, nil
+	case runtime.GOOS == "linux" && os.Getenv("DBUS_SESSION_BUS_ADDRESS") != "" && commandExists("secret-tool"):
+		return SystemKeyring{command: "secret-tool"}, nil
+	}
+	home, err := homedir.Dir()
+	if err != nil {
+		return nil, err
+	}
+	return FileKeyring{Path: path.Join(home, ".config", "rlctl", "credentials")}, nil
+}
+
+// GitlabTokenProviders returns the providers of the GitLab token in the order they are asked.
+func GitlabTokenProviders() ([]TokenProvider, error) {
+	keyring, err := DefaultKeyring()
+	if err != nil {
+		return nil, err
+	}
+	return []TokenProvider{
+		EnvTokenProvider{Variable: GitlabTokenEnv},
+		CommandTokenProvider{Command: viper.GetString(GitlabTokenCommand)},
+		KeyringTokenProvider{Keyring: keyring, Key: GitlabToken},
+		configTokenProvider{},
+	}, nil
+}
+
+// GetGitlabToken returns the token of the first provider which has one. A plain text token found in the config file
+// is moved into the keyring.
+func GetGitlabToken() (string, error) {
+	providers, err := GitlabTokenProviders()
+	if err != nil {
+		return "", err
+	}
+	for _, provider := range providers {
+		token, err := provider.Token()
+		if err != nil {
+			return "", err
+		}
+		if token == "" {
+			continue
+		}
+		if _, legacy := provider.(configTokenProvider); legacy {
+			log.Printf("Moving the plain text gitlab token of %s into the keyring\n", provider.Name())
+			if err = SetGitlabToken(token); err != nil {
+				return "", err
+			}
+		}
+		return token, nil
+	}
+	return "", nil
+}
+
+// SetGitlabToken stores the token in the keyring and removes a plain text token from the config file.
+func SetGitlabToken(token string) error {
+	keyring, err := DefaultKeyring()
+	if err != nil {
+		return err
+	}
+	if err = keyring.Set(GitlabToken, token); err != nil {
+		return err
+	}
+	return removeConfigValue(GitlabToken)
+}
+
+// DeleteGitlabToken removes the token from the keyring and the config file.
+func DeleteGitlabToken() error {
+	keyring, err := DefaultKeyring()
+	if err != nil {
+		return err
+	}
+	if err = keyring.Delete(GitlabToken); err != nil {
+		return err
+	}
+	return removeConfigValue(GitlabToken)
+}
+
+func commandExists(name string) bool {
+	_, err := exec.LookPath(name)
+	return err == nil
+}
+
+func shellCommand(command string) *exec.Cmd {
+	if runtime.GOOS == "windows" {
+		return exec.Command("cmd", "/C", command)
+	}
+	return exec.Command("sh", "-c", command)
+}
@@ -0,0 +1,105 @@
+package util_test
+
+import (
+	"github.com/rocketlaunchercloud/rlctl/util"
+	"github.com/spf13/viper"
+	"io/ioutil"
+	"os"
+	"path"
+	"strings"
+	"testing"
+)
+
+func TestFileKeyring(t *testing.T) {
+	dir, err := ioutil.TempDir("", "rlctl-keyring")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	keyring := util.FileKeyring{Path: path.Join(dir, "rlctl", "credentials")}
+	if secret, err := keyring.Get("gitlab-token"); err != nil || secret != "" {
+		t.Fatal("unexpected secret", secret, err)
+	}
+	if err = keyring.Set("gitlab-token", "secret"); err != nil {
+		t.Fatal(err)
+	}
+	if info, _ := os.Stat(keyring.Path); info.Mode().Perm() != 0600 {
+		t.Errorf("expected mode 0600, got %#o", info.Mode().Perm())
+	}
+	if secret, err := keyring.Get("gitlab-token"); err != nil || secret != "secret" {
+		t.Fatal("unexpected secret", secret, err)
+	}
+
+	// A keyring others can read must not be used
+	os.Chmod(keyring.Path, 0644)
+	if _, err = keyring.Get("gitlab-token"); util.KindOf(err) != util.ValidationError {
+		t.Error("expected a validation error, got", err)
+	}
+	os.Chmod(keyring.Path, 0600)
+
+	if err = keyring.Delete("gitlab-token"); err != nil {
+		t.Fatal(err)
+	}
+	if secret, _ := keyring.Get("gitlab-token"); secret != "" {
+		t.Error("deleted secret returned", secret)
+	}
+}
+
+func TestTokenProviders(t *testing.T) {
+	os.Setenv("RLCTL_TEST_TOKEN", " from-env\n")
+	defer os.Unsetenv("RLCTL_TEST_TOKEN")
+	providers := map[util.TokenProvider]string{
+		util.EnvTokenProvider{Variable: "RLCTL_TEST_TOKEN"}:            "from-env",
+		util.EnvTokenProvider{Variable: "RLCTL_TEST_UNSET"}:            "",
+		util.CommandTokenProvider{Command: "printf 'from-cmd\\nline'"}: "from-cmd",
+		util.CommandTokenProvider{}:                                    "",
+	}
+	for provider, expected := range providers {
+		if token, err := provider.Token(); err != nil || token != expected {
+			t.Errorf("%s: expected %q, got %q %v", provider.Name(), expected, token, err)
+		}
+	}
+	if _, err := (util.CommandTokenProvider{Command: "exit 3"}).Token(); err == nil {
+		t.Error("expected the failing command to fail")
+	}
+}
+
+func TestGitlabTokenMigration(t *testing.T) {
+	dir, err := ioutil.TempDir("", "rlctl-config")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	keyringPath := path.Join(dir, "credentials")
+	os.Setenv(util.KeyringFileEnv, keyringPath)
+	defer os.Unsetenv(util.KeyringFileEnv)
+
+	configPath := path.Join(dir, ".rlctl.yaml")
+	if err = ioutil.WriteFile(configPath, []byte("gitlab-token: plain\nauthor: me\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	viper.Reset()
+	defer viper.Reset()
+	viper.SetConfigFile(configPath)
+	if err = viper.ReadInConfig(); err != nil {
+		t.Fatal(err)
+	}
+
+	if token, err := util.GetGitlabToken(); err != nil || token != "plain" {
+		t.Fatal("unexpected token", token, err)
+	}
+	config, _ := ioutil.ReadFile(configPath)
+	if strings.Contains(string(config), "plain") || !strings.Contains(string(config), "author: me") {
+		t.Errorf("unexpected config %q", config)
+	}
+	if info, _ := os.Stat(configPath); info.Mode().Perm() != 0600 {
+		t.Errorf("expected mode 0600, got %#o", info.Mode().Perm())
+	}
+	if secret, _ := (util.FileKeyring{Path: keyringPath}).Get(util.GitlabToken); secret != "plain" {
+		t.Error("the token was not moved into the keyring")
+	}
+	if token, _ := util.GetGitlabToken(); token != "plain" {
+		t.Error("unexpected token after the migration", token)
+	}
+}
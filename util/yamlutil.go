@@ -0,0 +1,349 @@
+package util
+
+import (
+	"bytes"
+	"fmt"
+	"gopkg.in/yaml.v3"
+	"io"
+	"reflect"
+	"regexp"
+	"strings"
+)
+
+// placeholderPattern matches the mustache placeholders mo.sh replaces, e.g. {{ IMAGE_NAME }}, which are not YAML.
+var placeholderPattern = regexp.MustCompile(`\{\{[^{}]*\}\}`)
+
+// YamlConflict is a value which differs between the existing document and the patch, the existing value is kept.
+// Path is dot separated, list items are [index] or [name], documents of multi-document files are prefixed with
+// kind/name or their position.
+type YamlConflict struct {
+	Path     string
+	Line     int
+	Existing string
+	Patch    string
+}
+
+func (conflict YamlConflict) String() string {
+	return fmt.Sprintf("%s (line %d): kept %s, the template has %s", conflict.Path, conflict.Line, conflict.Existing, conflict.Patch)
+}
+
+// MergeYaml deep-merges patch, e.g. a rendered template, into existing and keeps the comments, the key order and the
+// style of existing. base is the document existing was created from, it may be nil:
+//
+//   - keys and list items of patch missing in existing are added, unless base has them, i.e. they were removed
+//   - differing values are replaced if existing still has the value of base, otherwise they are kept and reported
+//
+// Lists of mappings with a name key, like Kubernetes containers, are merged by name. Documents of multi-document files
+// are matched by kind and metadata.name if they have both, otherwise by position. The result is nil if nothing
+// changed.
+func MergeYaml(existing, base, patch []byte) ([]byte, []YamlConflict, error) {
+	placeholders := map[string]string{}
+	existingDocuments, err := decodeYamlDocuments(existing, placeholders)
+	if err != nil {
+		return nil, nil, err
+	}
+	baseDocuments, err := decodeYamlDocuments(base, placeholders)
+	if err != nil {
+		return nil, nil, err
+	}
+	patchDocuments, err := decodeYamlDocuments(patch, placeholders)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	merger := &yamlMerger{placeholders: placeholders}
+	for i, document := range patchDocuments {
+		key := documentKey(document, i)
+		prefix := ""
+		if len(patchDocuments) > 1 {
+			prefix = key + ": "
+		}
+		target := findDocument(existingDocuments, key)
+		if target == nil {
+			if findDocument(baseDocuments, key) == nil {
+				existingDocuments = append(existingDocuments, document)
+				merger.changed = true
+			}
+			continue
+		}
+		merger.merge(target, findDocument(baseDocuments, key), document, prefix)
+	}
+	if !merger.changed {
+		return nil, merger.conflicts, nil
+	}
+
+	var buffer bytes.Buffer
+	encoder := yaml.NewEncoder(&buffer)
+	encoder.SetIndent(yamlIndent(existing))
+	for _, document := range existingDocuments {
+		if err = encoder.Encode(document); err != nil {
+			return nil, nil, err
+		}
+	}
+	if err = encoder.Close(); err != nil {
+		return nil, nil, err
+	}
+	return []byte(merger.restore(buffer.String())), merger.conflicts, nil
+}
+
+type yamlMerger struct {
+	placeholders map[string]string
+	changed      bool
+	conflicts    []YamlConflict
+}
+
+// restore replaces the tokens of the placeholders.
+func (merger *yamlMerger) restore(text string) string {
+	for placeholder, token := range merger.placeholders {
+		text = strings.ReplaceAll(text, token, placeholder)
+	}
+	return text
+}
+
+func (merger *yamlMerger) merge(existing, base, patch *yaml.Node, path string) {
+	existing, base, patch = content(existing), content(base), content(patch)
+	switch {
+	case existing.Kind == yaml.MappingNode && patch.Kind == yaml.MappingNode:
+		merger.mergeMappings(existing, base, patch, path)
+	case existing.Kind == yaml.SequenceNode && patch.Kind == yaml.SequenceNode && namedItems(patch) && namedItems(existing):
+		merger.mergeNamedItems(existing, base, patch, path)
+	case existing.Kind == yaml.SequenceNode && patch.Kind == yaml.SequenceNode && scalarItems(patch) && scalarItems(existing):
+		merger.mergeScalarItems(existing, base, patch)
+	case sameValue(existing, patch):
+	case base != nil && sameValue(existing, base):
+		existing.Kind, existing.Tag, existing.Value, existing.Style, existing.Content = patch.Kind, patch.Tag, patch.Value, patch.Style, patch.Content
+		merger.changed = true
+	default:
+		merger.conflicts = append(merger.conflicts, YamlConflict{
+			Path:     path,
+			Line:     existing.Line,
+			Existing: merger.restore(nodeString(existing)),
+			Patch:    merger.restore(nodeString(patch)),
+		})
+	}
+}
+
+func (merger *yamlMerger) mergeMappings(existing, base, patch *yaml.Node, path string) {
+	for i := 0; i+1 < len(patch.Content); i += 2 {
+		key, value := patch.Content[i], patch.Content[i+1]
+		baseValue := mappingValue(base, key.Value)
+		if existingValue := mappingValue(existing, key.Value); existingValue != nil {
+			merger.merge(existingValue, baseValue, value, childPath(path, key.Value))
+			continue
+		}
+		if baseValue == nil {
+			// Insert after the key preceding it in patch, e.g. a job after the job of the previous stage
+			position := len(existing.Content)
+			if i > 0 {
+				if previous := mappingIndex(existing, patch.Content[i-2].Value); previous >= 0 {
+					position = previous + 2
+				}
+			}
+			existing.Content = append(existing.Content[:position], append([]*yaml.Node{key, value}, existing.Content[position:]...)...)
+			merger.changed = true
+		}
+	}
+}
+
+func (merger *yamlMerger) mergeNamedItems(existing, base, patch *yaml.Node, path string) {
+	for _, item := range patch.Content {
+		name := mappingValue(item, "name").Value
+		if existingItem := namedItem(existing, name); existingItem != nil {
+			merger.merge(existingItem, namedItem(base, name), item, path+"["+name+"]")
+			continue
+		}
+		if namedItem(base, name) == nil {
+			existing.Content = append(existing.Content, item)
+			merger.changed = true
+		}
+	}
+}
+
+// mergeScalarItems inserts the missing items after the item preceding them in patch, e.g. a stage between two
+// existing stages.
+func (merger *yamlMerger) mergeScalarItems(existing, base, patch *yaml.Node) {
+	for i, item := range patch.Content {
+		if scalarIndex(existing, item.Value) >= 0 || scalarIndex(base, item.Value) >= 0 {
+			continue
+		}
+		position := len(existing.Content)
+		if i > 0 {
+			if previous := scalarIndex(existing, patch.Content[i-1].Value); previous >= 0 {
+				position = previous + 1
+			}
+		}
+		existing.Content = append(existing.Content[:position], append([]*yaml.Node{item}, existing.Content[position:]...)...)
+		merger.changed = true
+	}
+}
+
+// childPath appends the key to the path of its mapping.
+func childPath(path, key string) string {
+	if path == "" || strings.HasSuffix(path, ": ") {
+		return path + key
+	}
+	return path + "." + key
+}
+
+// decodeYamlDocuments parses every document of data, placeholders are replaced by tokens which are recorded in
+// placeholders.
+func decodeYamlDocuments(data []byte, placeholders map[string]string) ([]*yaml.Node, error) {
+	protected := placeholderPattern.ReplaceAllStringFunc(string(data), func(placeholder string) string {
+		if _, found := placeholders[placeholder]; !found {
+			placeholders[placeholder] = fmt.Sprintf("rlctl-placeholder-%d", len(placeholders))
+		}
+		return placeholders[placeholder]
+	})
+
+	var documents []*yaml.Node
+	decoder := yaml.NewDecoder(strings.NewReader(protected))
+	for {
+		document := &yaml.Node{}
+		err := decoder.Decode(document)
+		if err == io.EOF {
+			return documents, nil
+		}
+		if err != nil {
+			return nil, NewValidationError("invalid YAML: %v", err)
+		}
+		// Separators without content, e.g. a trailing ---, are dropped
+		if len(document.Content) > 0 && !(document.Content[0].Kind == yaml.ScalarNode && document.Content[0].Tag == "!!null") {
+			documents = append(documents, document)
+		}
+	}
+}
+
+// documentKey identifies Kubernetes resources by kind and name, other documents by their position.
+func documentKey(document *yaml.Node, index int) string {
+	root := content(document)
+	kind := mappingValue(root, "kind")
+	name := mappingValue(mappingValue(root, "metadata"), "name")
+	if kind != nil && name != nil {
+		return kind.Value + "/" + name.Value
+	}
+	return fmt.Sprintf("document %d", index+1)
+}
+
+func findDocument(documents []*yaml.Node, key string) *yaml.Node {
+	for i, document := range documents {
+		if documentKey(document, i) == key {
+			return document
+		}
+	}
+	return nil
+}
+
+// yamlIndent returns the indentation of the first indented line, 2 if there is none.
+func yamlIndent(data []byte) int {
+	for _, line := range strings.Split(string(data), "\n") {
+		trimmed := strings.TrimLeft(line, " ")
+		if indent := len(line) - len(trimmed); indent > 0 && trimmed != "" && !strings.HasPrefix(trimmed, "#") &&
+			!strings.HasPrefix(trimmed, "- ") {
+			return indent
+		}
+	}
+	return 2
+}
+
+// content resolves documents and aliases, nil stays nil.
+func content(node *yaml.Node) *yaml.Node {
+	for node != nil {
+		switch {
+		case node.Kind == yaml.DocumentNode && len(node.Content) > 0:
+			node = node.Content[0]
+		case node.Kind == yaml.AliasNode && node.Alias != nil:
+			node = node.Alias
+		default:
+			return node
+		}
+	}
+	return nil
+}
+
+// mappingIndex returns the index of the key node in the content of the mapping, -1 if it has no such key.
+func mappingIndex(mapping *yaml.Node, key string) int {
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value == key {
+			return i
+		}
+	}
+	return -1
+}
+
+func mappingValue(node *yaml.Node, key string) *yaml.Node {
+	node = content(node)
+	if node == nil || node.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+	return nil
+}
+
+func namedItems(sequence *yaml.Node) bool {
+	for _, item := range sequence.Content {
+		if name := mappingValue(item, "name"); name == nil || name.Kind != yaml.ScalarNode {
+			return false
+		}
+	}
+	return len(sequence.Content) > 0
+}
+
+func namedItem(sequence *yaml.Node, name string) *yaml.Node {
+	if sequence == nil || sequence.Kind != yaml.SequenceNode {
+		return nil
+	}
+	for _, item := range sequence.Content {
+		if value := mappingValue(item, "name"); value != nil && value.Value == name {
+			return item
+		}
+	}
+	return nil
+}
+
+func scalarItems(sequence *yaml.Node) bool {
+	for _, item := range sequence.Content {
+		if item.Kind != yaml.ScalarNode {
+			return false
+		}
+	}
+	return true
+}
+
+func scalarIndex(sequence *yaml.Node, value string) int {
+	if sequence == nil || sequence.Kind != yaml.SequenceNode {
+		return -1
+	}
+	for i, item := range sequence.Content {
+		if item.Kind == yaml.ScalarNode && item.Value == value {
+			return i
+		}
+	}
+	return -1
+}
+
+// sameValue compares the decoded values, style and comments do not matter.
+func sameValue(a, b *yaml.Node) bool {
+	var first, second interface{}
+	if a.Decode(&first) != nil || b.Decode(&second) != nil {
+		return false
+	}
+	return reflect.DeepEqual(first, second)
+}
+
+func nodeString(node *yaml.Node) string {
+	if node.Kind == yaml.ScalarNode {
+		if node.Value == "" {
+			return "an empty value"
+		}
+		return node.Value
+	}
+	var value interface{}
+	if err := node.Decode(&value); err != nil {
+		return "?"
+	}
+	return fmt.Sprint(value)
+}
@@ -0,0 +1,134 @@
+package util_test
+
+import (
+	"github.com/rocketlaunchercloud/rlctl/util"
+	"strings"
+	"testing"
+)
+
+func TestMergeYaml(t *testing.T) {
+	base := []byte(`stages:
+  - build
+  - pack
+server:
+  port: 8080
+  host: localhost
+logging:
+  level: ERROR
+`)
+	existing := []byte(`# Application settings
+stages:
+  - build
+  - pack
+server:
+  port: 9090 # changed by the team
+  host: localhost
+custom: true
+`)
+	patch := []byte(`stages:
+  - build
+  - sonar
+  - pack
+server:
+  port: 8081
+  host: example.com
+  compression: true
+logging:
+  level: ERROR
+sonar:
+  enabled: true
+`)
+
+	merged, conflicts, err := util.MergeYaml(existing, base, patch)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := `# Application settings
+stages:
+  - build
+  - sonar
+  - pack
+server:
+  port: 9090 # changed by the team
+  host: example.com
+  compression: true
+custom: true
+sonar:
+  enabled: true
+`
+	if string(merged) != expected {
+		t.Errorf("expected\n%s\ngot\n%s", expected, merged)
+	}
+	if len(conflicts) != 1 || conflicts[0].Path != "server.port" || conflicts[0].Line != 6 || conflicts[0].Existing != "9090" ||
+		conflicts[0].Patch != "8081" {
+		t.Errorf("unexpected conflicts %v", conflicts)
+	}
+
+	if merged, conflicts, err = util.MergeYaml([]byte(expected), nil, []byte(expected)); err != nil || merged != nil || len(conflicts) != 0 {
+		t.Errorf("merging equal documents must change nothing: %s %v %v", merged, conflicts, err)
+	}
+	if _, _, err = util.MergeYaml([]byte("a: [b"), nil, patch); util.KindOf(err) != util.ValidationError {
+		t.Errorf("expected a validation error, got %v", err)
+	}
+}
+
+func TestMergeYamlKubernetes(t *testing.T) {
+	existing := []byte(`## Created by Rlctl
+apiVersion: apps/v1beta1
+kind: Deployment
+metadata:
+  name: orders
+spec:
+  template:
+    spec:
+      containers:
+        - name: orders
+          image: {{ IMAGE_NAME }}
+          env:
+            - name: PROFILE
+              value: "int"
+---
+kind: Service
+apiVersion: v1
+metadata:
+  name: orders
+`)
+	patch := []byte(`kind: Service
+apiVersion: v1
+metadata:
+  name: orders
+  labels:
+    expose: http
+---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: orders
+spec:
+  template:
+    spec:
+      containers:
+        - name: orders
+          image: {{ IMAGE_NAME }}
+          env:
+            - name: PROFILE
+              value: "int"
+            - name: JAVA_OPTS
+              value: "-Xmx1g"
+---
+`)
+
+	merged, conflicts, err := util.MergeYaml(existing, nil, patch)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, expected := range []string{"## Created by Rlctl\napiVersion: apps/v1beta1", "image: {{ IMAGE_NAME }}",
+		"            - name: JAVA_OPTS\n", "  name: orders\n  labels:\n    expose: http\n"} {
+		if !strings.Contains(string(merged), expected) {
+			t.Errorf("%q is missing in\n%s", expected, merged)
+		}
+	}
+	if len(conflicts) != 1 || conflicts[0].String() != "Deployment/orders: apiVersion (line 2): kept apps/v1beta1, the template has apps/v1" {
+		t.Errorf("unexpected conflicts %v", conflicts)
+	}
+}